@@ -0,0 +1,35 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ciphermountain/deadenz/pkg/service/multiverse"
+)
+
+var (
+	runAnnounce = &cobra.Command{
+		Use:   "announce [message]",
+		Short: "Broadcast an announcement to every player in the multiverse",
+		Long:  "Broadcast an announcement to every player in the multiverse",
+		Args:  cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			client, err := multiverse.NewClient(fmt.Sprintf("%s:%d", host, port), multiverse.WithCredentials(clientCredentials(cmd.ErrOrStderr(), tlsConf)))
+			if err != nil {
+				fmt.Fprintf(cmd.ErrOrStderr(), "no multiverse connection: %s\n", err.Error())
+				os.Exit(1)
+			}
+
+			if err := client.Announce(context.Background(), strings.Join(args, " ")); err != nil {
+				fmt.Fprintf(cmd.ErrOrStderr(), "announcement failed: %s\n", err.Error())
+				os.Exit(1)
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), "announcement sent")
+		},
+	}
+)
@@ -2,18 +2,35 @@ package run
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/ciphermountain/deadenz/internal/listeners"
 	deadenz "github.com/ciphermountain/deadenz/pkg"
 	"github.com/ciphermountain/deadenz/pkg/components"
+	"github.com/ciphermountain/deadenz/pkg/middleware"
+	multiverseproto "github.com/ciphermountain/deadenz/pkg/proto/multiverse"
 	"github.com/ciphermountain/deadenz/pkg/service/core"
+	"github.com/ciphermountain/deadenz/pkg/service/multiverse"
 )
 
+func init() {
+	runClient.Flags().StringVar(&clientMultiverseHost, "multiverse-host", "", "optional multiverse service address to receive deaths from")
+	runClient.Flags().StringSliceVar(&clientMultiverseEvents, "multiverse-events", nil, "multiverse event types to receive such as LevelUpEvent; deaths are always received")
+}
+
+// multiverseReconnectDelay is how long the client waits before reconnecting to the multiverse.
+const multiverseReconnectDelay = 5 * time.Second
+
 var (
+	clientMultiverseHost   string
+	clientMultiverseEvents []string
+
 	runClient = &cobra.Command{
 		Use:   "client",
 		Short: "",
@@ -22,7 +39,7 @@ var (
 			addr := fmt.Sprintf("%s:%d", host, port)
 
 			// create grpc client
-			client, err := core.NewClient(addr)
+			client, err := core.NewClient(addr, core.WithCredentials(clientCredentials(cmd.ErrOrStderr(), tlsConf)))
 			if err != nil {
 				fmt.Fprintf(cmd.ErrOrStderr(), "no client connection: %s", err.Error())
 				os.Exit(1)
@@ -31,15 +48,13 @@ var (
 			// start command loop
 			commands := listeners.NewCommandEvent(deadenz.SpawninCommandType, listeners.EnglishCommands)
 
-			// TODO: start a process to listen for events from the multiverse
-
 			// TODO: get profile from another source
 			profile := &components.Profile{
 				UUID:          "1",
 				XP:            0,
 				Currency:      0,
 				BackpackLimit: 10,
-				Backpack:      []components.ItemType{},
+				Backpack:      components.Backpack{},
 				Stats: components.Stats{
 					Wit:   1,
 					Skill: 1,
@@ -54,82 +69,486 @@ var (
 
 			commands.SetDefaultCommand(defaultCmd)
 
+			multiverseEvents := make(chan *multiverseproto.Event)
+
+			if clientMultiverseHost != "" {
+				go listenToMultiverse(cmd, clientMultiverseHost, profile.UUID, multiverseEvents)
+			}
+
+			chInput := commands.Next()
+
 			for {
-				input := <-commands.Next()
-
-				switch input {
-				case deadenz.SpawninCommandType, deadenz.WalkCommandType:
-					// action commands get routed to the game service
-					next := runActionCommand(cmd, client, input, profile)
-					if next != nil {
-						commands.SetDefaultCommand(*next)
+				var entered listeners.Input
+
+				select {
+				case evt := <-multiverseEvents:
+					// deaths are applied between commands so the profile is never modified concurrently
+					if death := evt.GetCharacterDeath(); death != nil {
+						next := runMultiverseDeath(cmd, client, death, profile)
+						if next != nil {
+							commands.SetDefaultCommand(*next)
+						}
+
+						continue
 					}
-				case deadenz.BackpackCommandType, deadenz.CurrencyCommandType, deadenz.XPCommandType:
-					// data read commands can be run directly on the client
-					runDataReadCommand(cmd, client, input, profile)
-				case deadenz.ExitCommandType:
-					if err := client.Close(); err != nil {
-						fmt.Fprintf(cmd.ErrOrStderr(), "client exited with error: %s", err.Error())
-						os.Exit(1)
+
+					if message := renderMultiverseEvent(evt); message != "" {
+						fmt.Fprintf(cmd.OutOrStdout(), "\n[multiverse] %s\n", message)
 					}
 
-					fmt.Fprintln(cmd.OutOrStdout(), "client exited successfully")
-					os.Exit(0)
-				default:
+					continue
+				case entered = <-chInput:
+				}
+
+				var next *deadenz.CommandType
+
+				if handler, ok := clientCommands[entered.Command]; ok {
+					next = handler(cmd, client, commands, entered, profile)
+				} else if entered.Command.IsAction() {
+					// registered commands without client options get routed to the game service by name
+					next = runActionCommand(cmd, client, entered.Command, profile)
+				} else {
 					fmt.Fprintln(cmd.ErrOrStderr(), "unrecognized command")
 				}
+
+				if next != nil {
+					commands.SetDefaultCommand(*next)
+				}
+
+				chInput = commands.Next()
 			}
 		},
 	}
 )
 
+// clientCommand runs a command entered by the player and returns the next default command or nil to keep the
+// current default.
+type clientCommand func(
+	cmd *cobra.Command,
+	client *core.Client,
+	commands *listeners.CommandEvent,
+	input listeners.Input,
+	profile *components.Profile,
+) *deadenz.CommandType
+
+// clientCommands are the commands that need more input from the player or are answered by the client. Every other
+// registered action command is routed to the game service by name.
+var clientCommands = map[deadenz.CommandType]clientCommand{
+	deadenz.WalkCommandType:     runWalkCommand,
+	deadenz.RestCommandType:     runRestCommand,
+	deadenz.CraftCommandType:    runCraftCommand,
+	deadenz.StatusCommandType:   runStatusCommand,
+	deadenz.BackpackCommandType: runDataReadCommand,
+	deadenz.CurrencyCommandType: runDataReadCommand,
+	deadenz.XPCommandType:       runDataReadCommand,
+	deadenz.ExitCommandType:     runExitCommand,
+}
+
+// runActionCommand runs a registered command on the game service and returns the command suggested by the service.
 func runActionCommand(
 	cmd *cobra.Command,
 	client *core.Client,
 	input deadenz.CommandType,
 	profile *components.Profile,
+	opts ...deadenz.CommandOpt,
 ) *deadenz.CommandType {
-	var (
-		next   deadenz.CommandType
-		events []string
-		err    error
-	)
-
-	switch input {
-	case deadenz.SpawninCommandType:
-		events, profile, err = client.Spawnin(context.Background(), profile)
-		next = deadenz.WalkCommandType
-	case deadenz.WalkCommandType:
-		events, profile, err = client.Walk(context.Background(), profile)
-		next = deadenz.WalkCommandType
-
-		if profile.Active == nil {
-			next = deadenz.SpawninCommandType
+	summary, updated, err := client.Execute(context.Background(), profile, input, opts...)
+	if err != nil {
+		fmt.Fprintln(cmd.ErrOrStderr(), err.Error())
+
+		// walking too much can only be recovered by resting
+		if errors.Is(err, middleware.ErrWalkTooMuch) {
+			next := deadenz.RestCommandType
+
+			return &next
 		}
-	default:
+
 		return nil
 	}
 
+	*profile = *updated
+
+	for _, event := range summary.Events {
+		fmt.Fprintln(cmd.OutOrStdout(), event)
+	}
+
+	if summary.Steps > 1 {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s ran %d times earning %d xp and %d currency\n", input, summary.Steps, summary.XP, summary.Currency)
+	}
+
+	next := summary.Next
+
+	if summary.Stopped != nil {
+		fmt.Fprintf(cmd.OutOrStdout(), "stopped %s: %s\n", input, summary.Stopped.Error())
+
+		if errors.Is(summary.Stopped, middleware.ErrWalkTooMuch) {
+			next = deadenz.RestCommandType
+		}
+	}
+
+	return &next
+}
+
+func runExitCommand(
+	cmd *cobra.Command,
+	client *core.Client,
+	_ *listeners.CommandEvent,
+	_ listeners.Input,
+	_ *components.Profile,
+) *deadenz.CommandType {
+	if err := client.Close(); err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "client exited with error: %s", err.Error())
+		os.Exit(1)
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), "client exited successfully")
+	os.Exit(0)
+
+	return nil
+}
+
+// listenToMultiverse forwards events from the multiverse, reconnecting whenever the stream ends.
+func listenToMultiverse(cmd *cobra.Command, addr, uid string, events chan<- *multiverseproto.Event) {
+	client, err := multiverse.NewClient(addr, multiverse.WithCredentials(clientCredentials(cmd.ErrOrStderr(), tlsConf)))
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "no multiverse connection: %s\n", err.Error())
+
+		return
+	}
+
+	var opts []multiverse.FilterOpt
+
+	if len(clientMultiverseEvents) > 0 {
+		// deaths of the played character must always be received to be applied
+		types := []multiverseproto.EventType{multiverseproto.EventType_CharacterDeathEvent}
+
+		for _, name := range clientMultiverseEvents {
+			value, ok := multiverseproto.EventType_value[name]
+			if !ok {
+				fmt.Fprintf(cmd.ErrOrStderr(), "unknown multiverse event type: %s\n", name)
+
+				return
+			}
+
+			types = append(types, multiverseproto.EventType(value))
+		}
+
+		opts = append(opts, multiverse.WithEventTypes(types...))
+	}
+
+	var next uint64
+
+	for {
+		// resume after the last received event so that nothing is missed while reconnecting
+		streamOpts := opts
+		if next > 0 {
+			streamOpts = append([]multiverse.FilterOpt{multiverse.WithResumeFrom(next)}, opts...)
+		}
+
+		next = readMultiverseEvents(cmd, client, uid, next, streamOpts, events)
+
+		time.Sleep(multiverseReconnectDelay)
+	}
+}
+
+// readMultiverseEvents forwards events from a single stream until it ends and returns the sequence to resume from.
+func readMultiverseEvents(
+	cmd *cobra.Command,
+	client *multiverse.Client,
+	uid string,
+	next uint64,
+	opts []multiverse.FilterOpt,
+	events chan<- *multiverseproto.Event,
+) uint64 {
+	reader, err := client.NewEventsStreamReader(context.Background(), uid, opts...)
 	if err != nil {
-		fmt.Fprintf(cmd.ErrOrStderr(), "%s", err.Error())
+		fmt.Fprintf(cmd.ErrOrStderr(), "could not listen to the multiverse: %s\n", err.Error())
+
+		return next
+	}
+
+	defer reader.Close()
+
+	for {
+		evt, err := reader.Next()
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "stopped listening to the multiverse and will reconnect: %s\n", err.Error())
+
+			return next
+		}
 
+		next = evt.GetSequence() + 1
+		events <- evt
+	}
+}
+
+// renderMultiverseEvent describes an event from another player. Events that are not shown return an empty string.
+func renderMultiverseEvent(evt *multiverseproto.Event) string {
+	switch typed := evt.GetType().(type) {
+	case *multiverseproto.Event_ItemCrafted:
+		return fmt.Sprintf("someone crafted %s", typed.ItemCrafted.GetName())
+	case *multiverseproto.Event_RareItemFound:
+		return fmt.Sprintf("someone found %s", typed.RareItemFound.GetName())
+	case *multiverseproto.Event_LevelUp:
+		return fmt.Sprintf("another of your kind reached level %d", typed.LevelUp.GetLevel())
+	case *multiverseproto.Event_FirstSpawn:
+		return fmt.Sprintf("%s walked the multiverse for the first time", typed.FirstSpawn.GetName())
+	case *multiverseproto.Event_XpMilestone:
+		return fmt.Sprintf("someone passed %d xp", typed.XpMilestone.GetXp())
+	case *multiverseproto.Event_Announcement:
+		return typed.Announcement.GetMessage()
+	default:
+		return ""
+	}
+}
+
+func runMultiverseDeath(
+	cmd *cobra.Command,
+	client *core.Client,
+	death *multiverseproto.DeathByCharacterType,
+	profile *components.Profile,
+) *deadenz.CommandType {
+	opt := deadenz.WithMultiverseDeath(components.CharacterType(death.GetType()), death.GetMessage())
+
+	events, updated, err := client.Run(context.Background(), profile, deadenz.MultiverseDeathCommandType, opt)
+	if err != nil {
+		// deaths of other characters or of a character already replaced do not apply
 		return nil
 	}
 
+	*profile = *updated
+
+	fmt.Fprintln(cmd.OutOrStdout())
+	fmt.Fprintln(cmd.OutOrStdout(), "a death in the multiverse reached you")
+
 	for _, event := range events {
 		fmt.Fprintln(cmd.OutOrStdout(), event)
 	}
 
+	next := deadenz.DefaultNextCommand(profile)
+
 	return &next
 }
 
+func runWalkCommand(
+	cmd *cobra.Command,
+	client *core.Client,
+	_ *listeners.CommandEvent,
+	input listeners.Input,
+	profile *components.Profile,
+) *deadenz.CommandType {
+	var opts []deadenz.CommandOpt
+
+	// walking several steps is run by the game service in a single request
+	switch {
+	case len(input.Args) == 0:
+	case input.Args[0] == "until":
+		opts = append(opts, deadenz.WithRepeatUntilStopped())
+	default:
+		steps, err := strconv.ParseUint(input.Args[0], 10, 32)
+		if err != nil || steps == 0 {
+			fmt.Fprintln(cmd.ErrOrStderr(), "walk expects a number of steps or until")
+
+			return nil
+		}
+
+		opts = append(opts, deadenz.WithRepeat(uint(steps)))
+	}
+
+	return runActionCommand(cmd, client, input.Command, profile, opts...)
+}
+
+func runRestCommand(
+	cmd *cobra.Command,
+	client *core.Client,
+	commands *listeners.CommandEvent,
+	input listeners.Input,
+	profile *components.Profile,
+) *deadenz.CommandType {
+	method, err := commands.Prompt("How would you like to rest? (wait, pay, eat): ")
+	if err != nil {
+		fmt.Fprintln(cmd.ErrOrStderr(), err.Error())
+
+		return nil
+	}
+
+	var opts []deadenz.CommandOpt
+
+	switch method {
+	case "", "wait":
+	case "pay":
+		opts = append(opts, deadenz.WithRestCurrency())
+	case "eat":
+		food, ok := chooseFood(cmd, client, commands, profile)
+		if !ok {
+			return nil
+		}
+
+		opts = append(opts, deadenz.WithRestFood(food))
+	default:
+		fmt.Fprintln(cmd.ErrOrStderr(), "unrecognized rest")
+
+		return nil
+	}
+
+	return runActionCommand(cmd, client, input.Command, profile, opts...)
+}
+
+func chooseFood(
+	cmd *cobra.Command,
+	client *core.Client,
+	commands *listeners.CommandEvent,
+	profile *components.Profile,
+) (components.ItemType, bool) {
+	items, err := client.Items(context.Background())
+	if err != nil {
+		fmt.Fprintln(cmd.ErrOrStderr(), err.Error())
+
+		return 0, false
+	}
+
+	var food []components.Item
+
+	for _, item := range items {
+		if item.IsFood() && profile.Backpack.Quantity(item.Type) > 0 {
+			food = append(food, item)
+		}
+	}
+
+	if len(food) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "you have nothing to eat")
+
+		return 0, false
+	}
+
+	for idx, item := range food {
+		fmt.Fprintf(cmd.OutOrStdout(), "%d: %s\n", idx+1, item.Name)
+	}
+
+	input, err := commands.Prompt("Choose something to eat: ")
+	if err != nil {
+		fmt.Fprintln(cmd.ErrOrStderr(), err.Error())
+
+		return 0, false
+	}
+
+	choice, err := strconv.Atoi(input)
+	if err != nil || choice < 1 || choice > len(food) {
+		fmt.Fprintln(cmd.ErrOrStderr(), "unrecognized food")
+
+		return 0, false
+	}
+
+	return food[choice-1].Type, true
+}
+
+func runCraftCommand(
+	cmd *cobra.Command,
+	client *core.Client,
+	commands *listeners.CommandEvent,
+	input listeners.Input,
+	profile *components.Profile,
+) *deadenz.CommandType {
+	recipes, err := client.Recipes(context.Background())
+	if err != nil {
+		fmt.Fprintln(cmd.ErrOrStderr(), err.Error())
+
+		return nil
+	}
+
+	if len(recipes) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "there is nothing to craft")
+
+		return nil
+	}
+
+	for idx, recipe := range recipes {
+		fmt.Fprintf(cmd.OutOrStdout(), "%d: %s\n", idx+1, recipe.Name)
+	}
+
+	selected, err := commands.Prompt("Choose a recipe: ")
+	if err != nil {
+		fmt.Fprintln(cmd.ErrOrStderr(), err.Error())
+
+		return nil
+	}
+
+	choice, err := strconv.Atoi(selected)
+	if err != nil || choice < 1 || choice > len(recipes) {
+		fmt.Fprintln(cmd.ErrOrStderr(), "unrecognized recipe")
+
+		return nil
+	}
+
+	return runActionCommand(cmd, client, input.Command, profile, deadenz.WithRecipe(recipes[choice-1].Type))
+}
+
+func runStatusCommand(
+	cmd *cobra.Command,
+	client *core.Client,
+	_ *listeners.CommandEvent,
+	_ listeners.Input,
+	profile *components.Profile,
+) *deadenz.CommandType {
+	status, err := client.Status(context.Background(), profile)
+	if err != nil {
+		fmt.Fprintln(cmd.ErrOrStderr(), err.Error())
+
+		return nil
+	}
+
+	out := cmd.OutOrStdout()
+
+	if profile.Active == nil {
+		fmt.Fprintln(out, "character: none (spawnin to begin)")
+	} else {
+		fmt.Fprintf(out, "character: %s (x%d)\n", profile.Active.Name, profile.Active.Multiplier)
+	}
+
+	if status.ActiveItem == nil {
+		fmt.Fprintln(out, "active item: none")
+	} else {
+		fmt.Fprintf(out, "active item: %s\n", status.ActiveItem.Name)
+	}
+
+	fmt.Fprintf(out, "xp: %d\n", profile.XP)
+	fmt.Fprintf(out, "currency: %d\n", profile.Currency)
+	fmt.Fprintf(out, "stats: wit %d, skill %d, humor %d\n", profile.Stats.Wit, profile.Stats.Skill, profile.Stats.Humor)
+	fmt.Fprintf(out, "backpack: %d of %d slots used\n", profile.Backpack.Slots(), profile.BackpackLimit)
+
+	if profile.CarryCapacity > 0 {
+		fmt.Fprintf(out, "carrying: %d of %d weight\n", profile.Backpack.Weight(), profile.CarryCapacity)
+	}
+
+	if status.Walk != nil {
+		if status.Walk.Remaining > 0 {
+			fmt.Fprintf(out, "walks: %d remaining (%d per hour)\n", status.Walk.Remaining, status.Walk.Limit)
+		} else {
+			wait := time.Until(status.Walk.NextWalk).Round(time.Second)
+			fmt.Fprintf(out, "walks: none remaining; next walk in %s or rest\n", wait)
+		}
+	}
+
+	for _, command := range status.Commands {
+		if command.Remaining > 0 {
+			fmt.Fprintf(out, "%s: %d of %d remaining\n", command.Command, command.Remaining, command.Capacity)
+		} else {
+			wait := time.Until(command.NextUse).Round(time.Second)
+			fmt.Fprintf(out, "%s: none remaining; available in %s\n", command.Command, wait)
+		}
+	}
+
+	return nil
+}
+
 func runDataReadCommand(
 	cmd *cobra.Command,
 	client *core.Client,
-	input deadenz.CommandType,
+	_ *listeners.CommandEvent,
+	input listeners.Input,
 	profile *components.Profile,
-) {
-	switch input {
+) *deadenz.CommandType {
+	switch input.Command {
 	case deadenz.BackpackCommandType:
 		if len(profile.Backpack) == 0 {
 			fmt.Println("you have no items in your backpack")
@@ -138,26 +557,34 @@ func runDataReadCommand(
 			if err != nil {
 				fmt.Fprintln(cmd.ErrOrStderr(), err.Error())
 
-				return
+				return nil
 			}
 
 			fmt.Println("your backpack includes:")
 
-			for _, itemType := range profile.Backpack {
+			for _, stack := range profile.Backpack {
 				for _, item := range items {
-					if item.Type == itemType {
-						fmt.Fprintln(cmd.OutOrStdout(), item.Name)
+					if item.Type == stack.Type {
+						if stack.Quantity > 1 {
+							fmt.Fprintf(cmd.OutOrStdout(), "%s (x%d)\n", item.Name, stack.Quantity)
+						} else {
+							fmt.Fprintln(cmd.OutOrStdout(), item.Name)
+						}
 
-						continue
+						break
 					}
 				}
 			}
+
+			if profile.CarryCapacity > 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "carrying %d of %d weight\n", profile.Backpack.Weight(), profile.CarryCapacity)
+			}
 		}
 	case deadenz.XPCommandType:
 		fmt.Fprintf(cmd.OutOrStdout(), "you have %d xp\n", profile.XP)
 	case deadenz.CurrencyCommandType:
 		fmt.Fprintf(cmd.OutOrStdout(), "you have %d currency\n", profile.Currency)
-	default:
-		return
 	}
+
+	return nil
 }
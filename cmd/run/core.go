@@ -1,26 +1,45 @@
 package run
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"os"
 
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
+	"github.com/ciphermountain/deadenz/pkg/middleware"
 	proto "github.com/ciphermountain/deadenz/pkg/proto/core"
 	"github.com/ciphermountain/deadenz/pkg/service/core"
 	"github.com/ciphermountain/deadenz/pkg/service/multiverse"
+	"github.com/ciphermountain/deadenz/pkg/service/transport"
 )
 
 func init() {
 	runCore.Flags().BoolVar(&withMultiverse, "with-multiverse", false, "optionally connect to multiverse service")
 	runCore.Flags().StringVar(&multiverseHost, "multiverse-host", "127.0.0.1:8080", "host address to multiverse service")
+	runCore.Flags().StringVar(&multiverseKeys, "multiverse-keys", "", "optional path to a json file of key ids to hex secrets used to sign published events and registrations")
+	runCore.Flags().StringVar(&multiverseKeyID, "multiverse-key-id", "", "id of the key in the multiverse keys file to sign published events with")
+	runCore.Flags().StringVar(&multiverseOutbox, "multiverse-outbox", "", "optional path to keep unpublished multiverse events in between restarts")
+	runCore.Flags().IntVar(&multiverseOutboxCapacity, "multiverse-outbox-capacity", multiverse.DefaultPublisherConfig().Capacity, "most unpublished multiverse events kept before new events are dropped")
+	runCore.Flags().IntVar(&multiverseBatchSize, "multiverse-batch-size", multiverse.DefaultPublisherConfig().BatchSize, "most multiverse events published in a single request")
+	addClientTLSFlags(runCore, "multiverse", "multiverse", &multiverseTLS)
+	runCore.Flags().StringVar(&middlewareConfig, "middleware-config", "", "path to a json file declaring the middleware chains")
 }
 
 var (
-	withMultiverse bool
-	multiverseHost string
+	withMultiverse   bool
+	multiverseHost   string
+	middlewareConfig string
+	multiverseKeys   string
+	multiverseKeyID  string
+	multiverseTLS    transport.TLSConfig
+
+	multiverseOutbox         string
+	multiverseOutboxCapacity int
+	multiverseBatchSize      int
 
 	runCore = &cobra.Command{
 		Use:   "core",
@@ -33,17 +52,84 @@ var (
 			)
 
 			if withMultiverse {
-				if client, err = multiverse.NewClient(multiverseHost); err != nil {
+				opts, err := multiverseClientOpts(cmd.ErrOrStderr())
+				if err != nil {
+					fmt.Fprintf(cmd.ErrOrStderr(), "could not load multiverse keys: %s\n", err.Error())
+					os.Exit(1)
+				}
+
+				if client, err = multiverse.NewClient(multiverseHost, opts...); err != nil {
 					fmt.Fprintln(cmd.ErrOrStderr(), "could not connect to multiverse service")
 					os.Exit(1)
 				}
 			}
 
+			chain, err := loadChainConfig(middlewareConfig)
+			if err != nil {
+				fmt.Fprintf(cmd.ErrOrStderr(), "could not load middleware config: %s\n", err.Error())
+				os.Exit(1)
+			}
+
+			publisherConf := multiverse.DefaultPublisherConfig()
+			publisherConf.Outbox = multiverseOutbox
+			publisherConf.Capacity = multiverseOutboxCapacity
+			publisherConf.BatchSize = multiverseBatchSize
+
+			server, err := core.NewServer(client, chain, core.WithPublisherConfig(publisherConf))
+			if err != nil {
+				fmt.Fprintf(cmd.ErrOrStderr(), "could not create core service: %s\n", err.Error())
+				os.Exit(1)
+			}
+
 			log.Println("starting core service")
 
-			startServer(host, port, cmd.ErrOrStderr(), func(server grpc.ServiceRegistrar) {
-				proto.RegisterDeadenzServer(server, core.NewServer(client))
+			startServer(host, port, cmd.ErrOrStderr(), server.Ready, func(registrar grpc.ServiceRegistrar) {
+				proto.RegisterDeadenzServer(registrar, server)
 			})
+
+			if err := server.Close(); err != nil {
+				fmt.Fprintf(cmd.ErrOrStderr(), "core service closed with error: %s\n", err.Error())
+			}
 		},
 	}
 )
+
+// multiverseClientOpts connects with the multiverse tls credentials and signs published events when a multiverse
+// key is configured.
+func multiverseClientOpts(writer io.Writer) ([]multiverse.ClientOpt, error) {
+	opts := []multiverse.ClientOpt{multiverse.WithCredentials(clientCredentials(writer, multiverseTLS))}
+
+	if multiverseKeys == "" {
+		return opts, nil
+	}
+
+	keys, err := multiverse.LoadKeys(multiverseKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, ok := keys[multiverseKeyID]
+	if !ok {
+		return nil, fmt.Errorf("key %q not found", multiverseKeyID)
+	}
+
+	return append(opts, multiverse.WithSigner(multiverse.NewSigner(multiverseKeyID, secret))), nil
+}
+
+func loadChainConfig(path string) (middleware.ChainConfig, error) {
+	if path == "" {
+		return middleware.DefaultChainConfig(), nil
+	}
+
+	bts, err := os.ReadFile(path)
+	if err != nil {
+		return middleware.ChainConfig{}, err
+	}
+
+	var chain middleware.ChainConfig
+	if err := json.Unmarshal(bts, &chain); err != nil {
+		return middleware.ChainConfig{}, err
+	}
+
+	return chain, nil
+}
@@ -1,22 +1,138 @@
 package run
 
 import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
 	proto "github.com/ciphermountain/deadenz/pkg/proto/multiverse"
 	"github.com/ciphermountain/deadenz/pkg/service/multiverse"
+	"github.com/ciphermountain/deadenz/pkg/service/transport"
 )
 
+func init() {
+	runMultiverse.Flags().StringVar(&snapshotPath, "snapshot", "", "optional path to persist living characters between restarts")
+	runMultiverse.Flags().DurationVar(&snapshotInterval, "snapshot-interval", time.Minute, "how often living characters are saved to the snapshot")
+	runMultiverse.Flags().StringVar(&nodeID, "node-id", "", "optional id of this node among its peers; random when empty")
+	runMultiverse.Flags().StringSliceVar(&peerAddrs, "peer", nil, "address of a peered multiverse node to share the universe with; may be repeated")
+	addClientTLSFlags(runMultiverse, "peer", "peered node", &peerTLS)
+	runMultiverse.Flags().StringSliceVar(&peerIdentities, "peer-identity", nil, "client certificate name of a node or operator allowed to relay, sync and announce; may be repeated")
+	runMultiverse.Flags().BoolVar(&insecurePeers, "insecure-peers", false, "accept relay, sync and announce calls from any caller without a client certificate; for local development only")
+	runMultiverse.Flags().StringVar(&publisherKeys, "publisher-keys", "", "optional path to a json file of key ids to hex secrets; published events and registrations must be signed with one when set")
+	runMultiverse.Flags().DurationVar(&signatureWindow, "signature-window", multiverse.DefaultSignatureWindow, "how far a signed event timestamp may be from the current time")
+	runMultiverse.Flags().DurationVar(&eventRetention, "retention", multiverse.DefaultRetention, "how long events are kept for disconnected players to catch up on")
+}
+
 var (
+	snapshotPath     string
+	snapshotInterval time.Duration
+	eventRetention   time.Duration
+	nodeID           string
+	peerAddrs        []string
+	peerIdentities   []string
+	insecurePeers    bool
+	peerTLS          transport.TLSConfig
+	publisherKeys    string
+	signatureWindow  time.Duration
+
 	runMultiverse = &cobra.Command{
 		Use:   "multiverse",
 		Short: "",
 		Long:  "",
 		Run: func(cmd *cobra.Command, args []string) {
-			startServer(host, port, cmd.OutOrStderr(), func(server grpc.ServiceRegistrar) {
-				proto.RegisterMultiverseServer(server, multiverse.NewMultiverseServer())
+			if eventRetention <= 0 {
+				fmt.Fprintln(cmd.ErrOrStderr(), "event retention must be positive")
+				os.Exit(1)
+			}
+
+			// peer identities are only known from client certificates the server verifies
+			if len(peerIdentities) > 0 && !tlsConf.VerifyClients {
+				fmt.Fprintln(cmd.ErrOrStderr(), "peer identities require --tls-verify-clients")
+				os.Exit(1)
+			}
+
+			if insecurePeers && len(peerIdentities) > 0 {
+				fmt.Fprintln(cmd.ErrOrStderr(), "--insecure-peers cannot be combined with --peer-identity")
+				os.Exit(1)
+			}
+
+			registry := multiverse.NewCharacterRegistry()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan struct{})
+
+			if snapshotPath != "" {
+				if err := registry.LoadSnapshot(snapshotPath); err != nil {
+					fmt.Fprintf(cmd.ErrOrStderr(), "could not restore snapshot: %s\n", err.Error())
+					os.Exit(1)
+				}
+
+				log.Printf("restored %d living characters from %s", registry.Len(), snapshotPath)
+
+				go func() {
+					registry.RunSnapshots(ctx, snapshotPath, snapshotInterval)
+					close(done)
+				}()
+			} else {
+				close(done)
+			}
+
+			peers := make([]*multiverse.Client, 0, len(peerAddrs))
+
+			for _, addr := range peerAddrs {
+				peer, err := multiverse.NewClient(addr, multiverse.WithCredentials(clientCredentials(cmd.ErrOrStderr(), peerTLS)))
+				if err != nil {
+					fmt.Fprintf(cmd.ErrOrStderr(), "invalid peer %s: %s\n", addr, err.Error())
+					os.Exit(1)
+				}
+
+				peers = append(peers, peer)
+			}
+
+			opts := []multiverse.ServerOpt{
+				multiverse.WithCharacterRegistry(registry),
+				multiverse.WithRetention(eventRetention),
+				multiverse.WithNodeID(nodeID),
+				multiverse.WithPeerIdentities(peerIdentities...),
+			}
+
+			if insecurePeers {
+				log.Println("insecure peers: accepting relay, sync and announce calls from any caller; do not expose this node")
+
+				opts = append(opts, multiverse.WithInsecurePeers())
+			}
+
+			for _, peer := range peers {
+				opts = append(opts, multiverse.WithPeers(peer), multiverse.WithRegistrySync(peer))
+			}
+
+			if publisherKeys != "" {
+				keys, err := multiverse.LoadKeys(publisherKeys)
+				if err != nil {
+					fmt.Fprintf(cmd.ErrOrStderr(), "could not load publisher keys: %s\n", err.Error())
+					os.Exit(1)
+				}
+
+				opts = append(opts, multiverse.WithVerifier(multiverse.NewVerifier(keys, signatureWindow)))
+			}
+
+			server := multiverse.NewMultiverseServer(opts...)
+			defer server.Close()
+
+			go server.RunPruning(ctx, eventRetention)
+
+			startServer(host, port, cmd.OutOrStderr(), server.Ready, func(registrar grpc.ServiceRegistrar) {
+				proto.RegisterMultiverseServer(registrar, server)
 			})
+
+			// save a final snapshot after the server stops
+			cancel()
+			<-done
 		},
 	}
 )
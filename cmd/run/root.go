@@ -1,35 +1,51 @@
 package run
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log"
 	"net"
 	"os"
 	"os/signal"
+	"time"
 
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/ciphermountain/deadenz/pkg/service/transport"
 )
 
 func init() {
 	RootCmd.PersistentFlags().StringVarP(&host, "listen", "l", "", "address for service to listen on")
 	RootCmd.PersistentFlags().IntVarP(&port, "port", "p", 8080, "port for service to listen on")
+	RootCmd.PersistentFlags().StringVar(&tlsConf.Cert, "tls-cert", "", "optional PEM certificate presented to the other side of each connection")
+	RootCmd.PersistentFlags().StringVar(&tlsConf.Key, "tls-key", "", "PEM private key of the tls certificate")
+	RootCmd.PersistentFlags().StringVar(&tlsConf.CA, "tls-ca", "", "optional PEM bundle of authorities trusted to sign the certificate of the other side")
+	RootCmd.PersistentFlags().BoolVar(&tlsConf.VerifyClients, "tls-verify-clients", false, "require clients to present a certificate signed by the tls ca")
+	RootCmd.PersistentFlags().BoolVar(&tlsConf.Enabled, "tls", false, "connect to services with tls even without a certificate or ca")
+	RootCmd.PersistentFlags().StringVar(&tlsConf.ServerName, "tls-server-name", "", "optional name to verify server certificates against")
 
 	RootCmd.AddCommand(runCore)
 	RootCmd.AddCommand(runMultiverse)
 	RootCmd.AddCommand(runClient)
+	RootCmd.AddCommand(runAnnounce)
 }
 
 var (
-	host string
-	port int
+	host    string
+	port    int
+	tlsConf transport.TLSConfig
 
 	RootCmd = &cobra.Command{
 		Use:       "run",
 		Short:     "Run services for the deadenz game",
 		Long:      "Run services for the deadenz game",
-		ValidArgs: []string{"core", "multiverse", "client"},
+		ValidArgs: []string{"core", "multiverse", "client", "announce"},
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
 			log.SetOutput(cmd.OutOrStdout())
 			log.SetFlags(log.Ldate | log.Ltime | log.Lshortfile)
@@ -37,9 +53,23 @@ var (
 	}
 )
 
+// readinessInterval is how often the readiness of a service is checked.
+const readinessInterval = 10 * time.Second
+
 type protoRegisterFunc func(grpc.ServiceRegistrar)
 
-func startServer(host string, port int, writer io.Writer, f protoRegisterFunc) {
+// readinessFunc returns an error while a service is not ready to serve requests.
+type readinessFunc func(context.Context) error
+
+// startServer serves the registered services along with the grpc health and reflection services. Services report
+// not serving until the readiness check passes and are checked again on an interval.
+func startServer(host string, port int, writer io.Writer, ready readinessFunc, f protoRegisterFunc) {
+	creds, err := transport.ServerCredentials(tlsConf)
+	if err != nil {
+		fmt.Fprintf(writer, "invalid tls configuration: %s\n", err.Error())
+		os.Exit(1)
+	}
+
 	listen := fmt.Sprintf("%s:%d", host, port)
 
 	lis, err := net.Listen("tcp", listen)
@@ -51,19 +81,43 @@ func startServer(host string, port int, writer io.Writer, f protoRegisterFunc) {
 	log.Printf("grpc server listening on %s", listen)
 
 	opts := []grpc.ServerOption{
-		// grpc.Creds(insecure.NewCredentials()),
+		grpc.Creds(creds),
 	}
 
 	grpcServer := grpc.NewServer(opts...)
 	c := make(chan os.Signal, 1)
 
 	f(grpcServer)
+
+	services := make([]string, 0, len(grpcServer.GetServiceInfo())+1)
+	for name := range grpcServer.GetServiceInfo() {
+		services = append(services, name)
+	}
+
+	// the empty name reports the health of the server as a whole
+	services = append(services, "")
+
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	for _, service := range services {
+		healthServer.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+	reflection.Register(grpcServer)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go watchReadiness(ctx, healthServer, services, ready)
+
 	signal.Notify(c, os.Interrupt)
 
 	go func() {
 		for range c {
 			log.Println("received stop notification")
 
+			cancel()
+			healthServer.Shutdown()
 			grpcServer.GracefulStop()
 		}
 	}()
@@ -73,3 +127,68 @@ func startServer(host string, port int, writer io.Writer, f protoRegisterFunc) {
 		os.Exit(1)
 	}
 }
+
+// watchReadiness sets the serving status of the services from the readiness check until the context ends.
+func watchReadiness(ctx context.Context, server *health.Server, services []string, ready readinessFunc) {
+	ticker := time.NewTicker(readinessInterval)
+	defer ticker.Stop()
+
+	var last error
+
+	for {
+		status := healthpb.HealthCheckResponse_SERVING
+
+		checkCtx, cancel := context.WithTimeout(ctx, readinessInterval)
+		err := ready(checkCtx)
+
+		cancel()
+
+		if err != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+
+			if last == nil || err.Error() != last.Error() {
+				log.Printf("service not ready: %s", err.Error())
+			}
+		} else if last != nil {
+			log.Println("service ready")
+		}
+
+		last = err
+
+		// the server may have shut down while the check ran
+		if ctx.Err() != nil {
+			return
+		}
+
+		for _, service := range services {
+			server.SetServingStatus(service, status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// addClientTLSFlags registers prefixed flags for the tls config a service dials another service with so that
+// outbound connections do not share the certificate and authorities the service listens with.
+func addClientTLSFlags(cmd *cobra.Command, prefix, service string, conf *transport.TLSConfig) {
+	cmd.Flags().StringVar(&conf.CA, prefix+"-tls-ca", "", fmt.Sprintf("optional PEM bundle of authorities trusted to sign the %s certificate", service))
+	cmd.Flags().StringVar(&conf.Cert, prefix+"-tls-cert", "", fmt.Sprintf("optional PEM client certificate presented to the %s", service))
+	cmd.Flags().StringVar(&conf.Key, prefix+"-tls-key", "", fmt.Sprintf("PEM private key of the %s client certificate", service))
+	cmd.Flags().StringVar(&conf.ServerName, prefix+"-tls-server-name", "", fmt.Sprintf("optional name to verify the %s certificate against", service))
+	cmd.Flags().BoolVar(&conf.Enabled, prefix+"-tls", false, fmt.Sprintf("connect to the %s with tls even without a certificate or ca", service))
+}
+
+// clientCredentials returns the credentials clients dial services with and exits on an invalid configuration.
+func clientCredentials(writer io.Writer, conf transport.TLSConfig) credentials.TransportCredentials {
+	creds, err := transport.ClientCredentials(conf)
+	if err != nil {
+		fmt.Fprintf(writer, "invalid tls configuration: %s\n", err.Error())
+		os.Exit(1)
+	}
+
+	return creds
+}
@@ -10,10 +10,16 @@ import (
 	deadenz "github.com/ciphermountain/deadenz/pkg"
 )
 
+// Input is a command entered by the player with any arguments that followed it.
+type Input struct {
+	Command deadenz.CommandType
+	Args    []string
+}
+
 type CommandEvent struct {
 	reader     *bufio.Reader
 	commands   map[string]deadenz.CommandType
-	chCommands chan deadenz.CommandType
+	chCommands chan Input
 	chPrompt   chan struct{}
 
 	mu             sync.Mutex
@@ -27,7 +33,7 @@ func NewCommandEvent(
 	listener := &CommandEvent{
 		reader:         bufio.NewReader(os.Stdin),
 		commands:       commands,
-		chCommands:     make(chan deadenz.CommandType, 1),
+		chCommands:     make(chan Input, 1),
 		chPrompt:       make(chan struct{}, 1),
 		defaultCommand: defaultCommand,
 	}
@@ -37,7 +43,7 @@ func NewCommandEvent(
 	return listener
 }
 
-func (e *CommandEvent) Next() <-chan deadenz.CommandType {
+func (e *CommandEvent) Next() <-chan Input {
 	e.chPrompt <- struct{}{}
 
 	return e.chCommands
@@ -50,6 +56,19 @@ func (e *CommandEvent) SetDefaultCommand(command deadenz.CommandType) {
 	e.defaultCommand = command
 }
 
+// Prompt writes the message and reads a single line of input. It is only safe to call between receiving a
+// command from Next and the next call to Next.
+func (e *CommandEvent) Prompt(message string) (string, error) {
+	fmt.Print(message)
+
+	input, err := e.reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(input, "\n"), nil
+}
+
 func (e *CommandEvent) run() {
 	for {
 		<-e.chPrompt
@@ -58,7 +77,7 @@ func (e *CommandEvent) run() {
 		def := e.defaultCommand
 		e.mu.Unlock()
 
-		var defStr string
+		defStr := def.String()
 		for key, value := range e.commands {
 			if value == def {
 				defStr = string(key)
@@ -80,20 +99,28 @@ func (e *CommandEvent) run() {
 		input = strings.TrimSuffix(input, "\n")
 
 		// set default input
-		if len(input) == 0 {
-			e.chCommands <- def
+		fields := strings.Fields(input)
+		if len(fields) == 0 {
+			e.chCommands <- Input{Command: def}
 
-			return
+			continue
 		}
 
-		cmd, ok := e.commands[input]
+		cmd, ok := e.commands[fields[0]]
 		if !ok {
-			fmt.Println("unrecognized command")
+			// fall back to commands registered with the engine
+			var err error
+
+			if cmd, err = deadenz.ParseCommandType(fields[0]); err != nil {
+				fmt.Println("unrecognized command")
 
-			return
+				e.chPrompt <- struct{}{}
+
+				continue
+			}
 		}
 
-		e.chCommands <- cmd
+		e.chCommands <- Input{Command: cmd, Args: fields[1:]}
 	}
 }
 
@@ -103,6 +130,9 @@ var EnglishCommands map[string]deadenz.CommandType = map[string]deadenz.CommandT
 	"backpack": deadenz.BackpackCommandType,
 	"xp":       deadenz.XPCommandType,
 	"currency": deadenz.CurrencyCommandType,
+	"craft":    deadenz.CraftCommandType,
+	"rest":     deadenz.RestCommandType,
+	"status":   deadenz.StatusCommandType,
 	"exit":     deadenz.ExitCommandType,
 	"quit":     deadenz.ExitCommandType,
 }
@@ -2,6 +2,7 @@ package util
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"sync"
@@ -9,6 +10,12 @@ import (
 	"time"
 )
 
+var (
+	ErrMissingLoader = errors.New("loader does not exist")
+	ErrMissingParser = errors.New("parser does not exist")
+	ErrLoadFailed    = errors.New("data could not be loaded")
+)
+
 type Parser func([]byte, any) error
 
 type Loader interface {
@@ -75,20 +82,20 @@ func (l *DataLoader) LoadCtx(ctx context.Context, value any) error {
 
 	config, exists := l.configs[tp]
 	if !exists || config.loader == nil {
-		return fmt.Errorf("loader does not exist for %+v", tp)
+		return fmt.Errorf("%w for %+v", ErrMissingLoader, tp)
 	}
 
 	if config.parser == nil {
-		return fmt.Errorf("parser does not exist for %+v", tp)
+		return fmt.Errorf("%w for %+v", ErrMissingParser, tp)
 	}
 
 	bts, err := config.loader.Data(ctx)
 	if err != nil {
-		return err
+		return fmt.Errorf("%w for %+v: %w", ErrLoadFailed, tp, err)
 	}
 
 	if err := config.parser(bts, value); err != nil {
-		return err
+		return fmt.Errorf("%w for %+v: %w", ErrLoadFailed, tp, err)
 	}
 
 	// l.data[tp] = value
@@ -96,6 +103,18 @@ func (l *DataLoader) LoadCtx(ctx context.Context, value any) error {
 	return nil
 }
 
+// Check loads and parses the data of each key without keeping the result. An error is returned for every key that
+// is not configured or whose data cannot be loaded or parsed.
+func (l *DataLoader) Check(ctx context.Context, keys ...reflect.Type) error {
+	var err error
+
+	for _, key := range keys {
+		err = errors.Join(err, l.LoadCtx(ctx, reflect.New(key).Interface()))
+	}
+
+	return err
+}
+
 func (l *DataLoader) Start() error {
 	l.starter.Do(func() {
 		go l.run()
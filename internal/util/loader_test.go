@@ -30,6 +30,40 @@ func TestDataLoader(t *testing.T) {
 	assert.Equal(t, strVals, output)
 }
 
+func TestDataLoaderCheck(t *testing.T) {
+	t.Parallel()
+
+	loadedType := reflect.TypeOf([]string{})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		dataLoader := util.NewDataLoader()
+
+		// missing loaders fail the check
+		assert.ErrorIs(t, dataLoader.Check(context.Background(), loadedType), util.ErrMissingLoader)
+
+		loader := new(mocks.MockLoader)
+		loader.EXPECT().Data(mock.Anything).Return([]byte(`{"not":"a list"}`), nil)
+
+		require.NoError(t, dataLoader.SetLoader(loadedType, loader, json.Unmarshal))
+
+		// unparsable data fails the check
+		assert.ErrorIs(t, dataLoader.Check(context.Background(), loadedType), util.ErrLoadFailed)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		dataLoader := util.NewDataLoader()
+		loader := new(mocks.MockLoader)
+		loader.EXPECT().Data(mock.Anything).Return(encoded, nil)
+
+		require.NoError(t, dataLoader.SetLoader(loadedType, loader, json.Unmarshal))
+		assert.NoError(t, dataLoader.Check(context.Background(), loadedType))
+	})
+}
+
 var (
 	strVals    = []string{"one", "two", "three"}
 	encoded, _ = json.Marshal(strVals)
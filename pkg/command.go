@@ -1,7 +1,21 @@
 package deadenz
 
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/ciphermountain/deadenz/pkg/components"
+)
+
 type CommandType int
 
+// UnknownCommandType is the command type of a name that is not registered. It is never assigned to a command so
+// that an unknown name cannot be mistaken for a registered command.
+const UnknownCommandType CommandType = -1
+
 const (
 	ExitCommandType CommandType = iota
 	SpawninCommandType
@@ -9,4 +23,366 @@ const (
 	BackpackCommandType
 	XPCommandType
 	CurrencyCommandType
+	CraftCommandType
+	RestCommandType
+	StatusCommandType
+	MultiverseDeathCommandType
 )
+
+// CommandFunc applies a command to a profile and returns the updated profile with the events that occurred.
+type CommandFunc func(context.Context, *components.Profile, Loader, CommandConfig) (*components.Profile, []components.Event, error)
+
+// Command describes a game command. Commands without a handler are not run by the engine and are expected to be
+// handled by the client directly.
+type Command struct {
+	// Name is the canonical name of the command and must be unique.
+	Name string
+	// Aliases are alternative names the command can be parsed from.
+	Aliases []string
+	// Handler applies the command to a profile.
+	Handler CommandFunc
+	// Next returns the default command after the command succeeds. DefaultNextCommand is used when nil.
+	Next func(*components.Profile) CommandType
+	// Encode converts command options to named arguments to send the command over the wire.
+	Encode func(CommandConfig) map[string]string
+	// Decode converts named arguments received over the wire to command options. The arguments are provided to
+	// the handler unchanged in CommandConfig.Args when nil.
+	Decode func(map[string]string) ([]CommandOpt, error)
+	// Repeatable allows the command to be run several times in a single request.
+	Repeatable bool
+}
+
+var (
+	commandsMu   sync.RWMutex
+	commands     = make(map[CommandType]Command)
+	commandNames = make(map[string]CommandType)
+)
+
+func init() {
+	builtin := map[CommandType]Command{
+		ExitCommandType:     {Name: "exit", Aliases: []string{"quit"}},
+		SpawninCommandType:  {Name: "spawnin", Handler: spawnCommand},
+		WalkCommandType:     {Name: "walk", Handler: walkCommand, Encode: encodeRepeat, Decode: decodeRepeat, Repeatable: true},
+		BackpackCommandType: {Name: "backpack"},
+		XPCommandType:       {Name: "xp"},
+		CurrencyCommandType: {Name: "currency"},
+		CraftCommandType:    {Name: "craft", Handler: craftCommand, Encode: encodeCraft, Decode: decodeCraft},
+		RestCommandType:     {Name: "rest", Handler: restCommand, Encode: encodeRest, Decode: decodeRest},
+		StatusCommandType:   {Name: "status"},
+		MultiverseDeathCommandType: {
+			Name:    "multiverse_death",
+			Handler: multiverseDeathCommand,
+			Encode:  encodeMultiverseDeath,
+			Decode:  decodeMultiverseDeath,
+		},
+	}
+
+	for cmdType, cmd := range builtin {
+		register(cmdType, cmd)
+	}
+}
+
+// RegisterCommand makes a command available to the engine and returns the command type assigned to it. It
+// panics if the command has no name or if the name or any alias is already registered.
+func RegisterCommand(cmd Command) CommandType {
+	commandsMu.Lock()
+	defer commandsMu.Unlock()
+
+	next := CommandType(len(commands))
+	for cmdType := range commands {
+		if cmdType >= next {
+			next = cmdType + 1
+		}
+	}
+
+	return registerLocked(next, cmd)
+}
+
+// LookupCommand returns the registered command for the command type.
+func LookupCommand(cmdType CommandType) (Command, bool) {
+	commandsMu.RLock()
+	defer commandsMu.RUnlock()
+
+	cmd, ok := commands[cmdType]
+
+	return cmd, ok
+}
+
+// CommandNames returns the command type for every registered name and alias.
+func CommandNames() map[string]CommandType {
+	commandsMu.RLock()
+	defer commandsMu.RUnlock()
+
+	names := make(map[string]CommandType, len(commandNames))
+	for name, cmdType := range commandNames {
+		names[name] = cmdType
+	}
+
+	return names
+}
+
+// CommandTypes returns all registered command types in order.
+func CommandTypes() []CommandType {
+	commandsMu.RLock()
+	defer commandsMu.RUnlock()
+
+	types := make([]CommandType, 0, len(commands))
+	for cmdType := range commands {
+		types = append(types, cmdType)
+	}
+
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+
+	return types
+}
+
+// ParseCommandType returns the command type for the command name or alias. UnknownCommandType is returned with
+// the error when the name is not registered.
+func ParseCommandType(name string) (CommandType, error) {
+	commandsMu.RLock()
+	defer commandsMu.RUnlock()
+
+	cmd, ok := commandNames[name]
+	if !ok {
+		return UnknownCommandType, fmt.Errorf("%w: %s", ErrUnrecognizedCommand, name)
+	}
+
+	return cmd, nil
+}
+
+// String returns the canonical name of the command type.
+func (c CommandType) String() string {
+	if c == UnknownCommandType {
+		return "unknown"
+	}
+
+	if cmd, ok := LookupCommand(c); ok {
+		return cmd.Name
+	}
+
+	return fmt.Sprintf("command(%d)", int(c))
+}
+
+// IsAction indicates whether the command is run by the engine.
+func (c CommandType) IsAction() bool {
+	cmd, ok := LookupCommand(c)
+
+	return ok && cmd.Handler != nil
+}
+
+// DefaultNextCommand continues walking with an active character and otherwise spawns a new one.
+func DefaultNextCommand(profile *components.Profile) CommandType {
+	if profile == nil || profile.Active == nil {
+		return SpawninCommandType
+	}
+
+	return WalkCommandType
+}
+
+func register(cmdType CommandType, cmd Command) CommandType {
+	commandsMu.Lock()
+	defer commandsMu.Unlock()
+
+	return registerLocked(cmdType, cmd)
+}
+
+func registerLocked(cmdType CommandType, cmd Command) CommandType {
+	if cmd.Name == "" {
+		panic("deadenz: command name is empty")
+	}
+
+	if _, exists := commands[cmdType]; exists {
+		panic("deadenz: command registered twice for " + cmd.Name)
+	}
+
+	names := append([]string{cmd.Name}, cmd.Aliases...)
+	for _, name := range names {
+		if _, exists := commandNames[name]; exists {
+			panic("deadenz: command registered twice for " + name)
+		}
+	}
+
+	for _, name := range names {
+		commandNames[name] = cmdType
+	}
+
+	commands[cmdType] = cmd
+
+	return cmdType
+}
+
+// CommandOpt provides optional arguments to an action command.
+type CommandOpt func(*CommandConfig)
+
+// CommandConfig holds the optional arguments of an action command.
+type CommandConfig struct {
+	Recipe components.RecipeType
+	Rest   RestMethod
+	Food   components.ItemType
+	// Args are the named arguments of a registered command that does not decode them.
+	Args map[string]string
+	// Repeat is the number of times to run a repeatable command. Zero and one both run the command once.
+	Repeat uint
+	// Death is the character death reported by the multiverse.
+	Death MultiverseDeath
+}
+
+// WithRecipe selects the recipe to use for a craft command.
+func WithRecipe(recipe components.RecipeType) CommandOpt {
+	return func(conf *CommandConfig) {
+		conf.Recipe = recipe
+	}
+}
+
+// WithRestCurrency pays currency for a rest command.
+func WithRestCurrency() CommandOpt {
+	return func(conf *CommandConfig) {
+		conf.Rest = RestWithCurrency
+	}
+}
+
+// WithRestFood eats the food item from the backpack for a rest command.
+func WithRestFood(food components.ItemType) CommandOpt {
+	return func(conf *CommandConfig) {
+		conf.Rest = RestWithFood
+		conf.Food = food
+	}
+}
+
+// WithArgs provides named arguments to a registered command.
+func WithArgs(args map[string]string) CommandOpt {
+	return func(conf *CommandConfig) {
+		conf.Args = args
+	}
+}
+
+// WithRepeat runs a repeatable command up to the provided number of times, limited to MaxRepeat.
+func WithRepeat(times uint) CommandOpt {
+	return func(conf *CommandConfig) {
+		conf.Repeat = min(times, MaxRepeat)
+	}
+}
+
+// WithRepeatUntilStopped runs a repeatable command until something stops it or MaxRepeat is reached.
+func WithRepeatUntilStopped() CommandOpt {
+	return WithRepeat(MaxRepeat)
+}
+
+// WithMultiverseDeath applies a death of the character type reported by the multiverse.
+func WithMultiverseDeath(character components.CharacterType, message string) CommandOpt {
+	return func(conf *CommandConfig) {
+		conf.Death = MultiverseDeath{Character: character, Message: message}
+	}
+}
+
+// EncodeCommand converts command options to the named arguments of the command.
+func EncodeCommand(cmdType CommandType, opts ...CommandOpt) (map[string]string, error) {
+	cmd, ok := LookupCommand(cmdType)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnrecognizedCommand, cmdType)
+	}
+
+	var conf CommandConfig
+	for _, opt := range opts {
+		opt(&conf)
+	}
+
+	if cmd.Encode == nil {
+		return conf.Args, nil
+	}
+
+	return cmd.Encode(conf), nil
+}
+
+// DecodeCommand converts the named arguments of the command to command options.
+func DecodeCommand(cmdType CommandType, args map[string]string) ([]CommandOpt, error) {
+	cmd, ok := LookupCommand(cmdType)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnrecognizedCommand, cmdType)
+	}
+
+	if cmd.Decode == nil {
+		return []CommandOpt{WithArgs(args)}, nil
+	}
+
+	return cmd.Decode(args)
+}
+
+func encodeRepeat(conf CommandConfig) map[string]string {
+	if conf.Repeat <= 1 {
+		return nil
+	}
+
+	return map[string]string{"repeat": strconv.FormatUint(uint64(conf.Repeat), 10)}
+}
+
+func decodeRepeat(args map[string]string) ([]CommandOpt, error) {
+	if args["repeat"] == "" {
+		return nil, nil
+	}
+
+	repeat, err := strconv.ParseUint(args["repeat"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid repeat: %w", err)
+	}
+
+	return []CommandOpt{WithRepeat(uint(repeat))}, nil
+}
+
+func encodeMultiverseDeath(conf CommandConfig) map[string]string {
+	return map[string]string{
+		"character": strconv.FormatUint(uint64(conf.Death.Character), 10),
+		"message":   conf.Death.Message,
+	}
+}
+
+func decodeMultiverseDeath(args map[string]string) ([]CommandOpt, error) {
+	character, err := strconv.ParseUint(args["character"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid character: %w", err)
+	}
+
+	return []CommandOpt{WithMultiverseDeath(components.CharacterType(character), args["message"])}, nil
+}
+
+func encodeCraft(conf CommandConfig) map[string]string {
+	return map[string]string{"recipe": strconv.FormatUint(uint64(conf.Recipe), 10)}
+}
+
+func decodeCraft(args map[string]string) ([]CommandOpt, error) {
+	recipe, err := strconv.ParseUint(args["recipe"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipe: %w", err)
+	}
+
+	return []CommandOpt{WithRecipe(components.RecipeType(recipe))}, nil
+}
+
+func encodeRest(conf CommandConfig) map[string]string {
+	switch conf.Rest {
+	case RestWithCurrency:
+		return map[string]string{"method": "currency"}
+	case RestWithFood:
+		return map[string]string{"method": "food", "food": strconv.FormatUint(uint64(conf.Food), 10)}
+	default:
+		return map[string]string{"method": "time"}
+	}
+}
+
+func decodeRest(args map[string]string) ([]CommandOpt, error) {
+	switch args["method"] {
+	case "", "time":
+		return nil, nil
+	case "currency":
+		return []CommandOpt{WithRestCurrency()}, nil
+	case "food":
+		food, err := strconv.ParseUint(args["food"], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid food: %w", err)
+		}
+
+		return []CommandOpt{WithRestFood(components.ItemType(food))}, nil
+	default:
+		return nil, fmt.Errorf("unrecognized rest method: %s", args["method"])
+	}
+}
@@ -0,0 +1,118 @@
+package deadenz_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	deadenz "github.com/ciphermountain/deadenz/pkg"
+	"github.com/ciphermountain/deadenz/pkg/components"
+)
+
+func TestRegisterCommand(t *testing.T) {
+	t.Parallel()
+
+	dig := deadenz.RegisterCommand(deadenz.Command{
+		Name:    "test_dig",
+		Aliases: []string{"test_shovel"},
+		Handler: func(_ context.Context, profile *components.Profile, _ deadenz.Loader, conf deadenz.CommandConfig) (*components.Profile, []components.Event, error) {
+			if conf.Args["deep"] == "true" {
+				profile.Currency += 2
+			}
+
+			profile.Currency++
+
+			return profile, nil, nil
+		},
+		Next: func(_ *components.Profile) deadenz.CommandType {
+			return deadenz.RestCommandType
+		},
+	})
+
+	t.Cleanup(func() { deadenz.UnregisterCommand(dig) })
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("registering a taken name should panic", func(t *testing.T) {
+			t.Parallel()
+
+			assert.Panics(t, func() {
+				deadenz.RegisterCommand(deadenz.Command{Name: "walk"})
+			})
+		})
+
+		t.Run("unregistered names parse as the unknown command", func(t *testing.T) {
+			t.Parallel()
+
+			cmdType, err := deadenz.ParseCommandType("test_unregistered")
+
+			require.ErrorIs(t, err, deadenz.ErrUnrecognizedCommand)
+			assert.Equal(t, deadenz.UnknownCommandType, cmdType)
+			assert.NotEqual(t, deadenz.ExitCommandType, cmdType)
+		})
+
+		t.Run("client only commands should not run", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := deadenz.RunWithMiddleware(context.Background(), deadenz.XPCommandType, &components.Profile{}, nil, nil)
+
+			require.ErrorIs(t, err, deadenz.ErrUnrecognizedCommand)
+		})
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("registered command is parsed by name and alias", func(t *testing.T) {
+			t.Parallel()
+
+			byName, err := deadenz.ParseCommandType("test_dig")
+			require.NoError(t, err)
+
+			byAlias, err := deadenz.ParseCommandType("test_shovel")
+			require.NoError(t, err)
+
+			assert.Equal(t, dig, byName)
+			assert.Equal(t, dig, byAlias)
+			assert.Equal(t, "test_dig", dig.String())
+			assert.True(t, dig.IsAction())
+		})
+
+		t.Run("registered command runs with decoded args", func(t *testing.T) {
+			t.Parallel()
+
+			args, err := deadenz.EncodeCommand(dig, deadenz.WithArgs(map[string]string{"deep": "true"}))
+			require.NoError(t, err)
+
+			opts, err := deadenz.DecodeCommand(dig, args)
+			require.NoError(t, err)
+
+			result, err := deadenz.RunWithMiddleware(context.Background(), dig, &components.Profile{}, nil, nil, opts...)
+
+			require.NoError(t, err)
+			assert.Equal(t, uint(3), result.Profile.Currency)
+			assert.Equal(t, deadenz.RestCommandType, result.DefaultCmd)
+		})
+
+		t.Run("builtin options survive the wire", func(t *testing.T) {
+			t.Parallel()
+
+			args, err := deadenz.EncodeCommand(deadenz.RestCommandType, deadenz.WithRestFood(7))
+			require.NoError(t, err)
+
+			opts, err := deadenz.DecodeCommand(deadenz.RestCommandType, args)
+			require.NoError(t, err)
+
+			var conf deadenz.CommandConfig
+			for _, opt := range opts {
+				opt(&conf)
+			}
+
+			assert.Equal(t, deadenz.RestWithFood, conf.Rest)
+			assert.Equal(t, components.ItemType(7), conf.Food)
+		})
+	})
+}
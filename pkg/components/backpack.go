@@ -0,0 +1,165 @@
+package components
+
+import "errors"
+
+var (
+	ErrNotEnoughSlots = errors.New("not enough open slots in backpack")
+	ErrTooHeavy       = errors.New("backpack cannot carry the added weight")
+	ErrNotEnoughItems = errors.New("not enough items in backpack")
+)
+
+// Stack is a quantity of a single item type that occupies one backpack slot. Weight is the weight of a single
+// unit of the item at the time it was added.
+type Stack struct {
+	Type     ItemType
+	Quantity uint32
+	Weight   uint32
+}
+
+// Backpack is an ordered list of item stacks where the most recently added stack is first. Each stack occupies
+// one slot.
+type Backpack []Stack
+
+// NewBackpackFromItems migrates a flat list of item types into a backpack where each item occupies its own slot.
+// Each stack weighs as much as the item of the same type in the provided items and items that are not found weigh
+// nothing.
+func NewBackpackFromItems(types []ItemType, items []Item) Backpack {
+	weights := make(map[ItemType]uint32, len(items))
+	for _, item := range items {
+		weights[item.Type] = item.Weight
+	}
+
+	backpack := make(Backpack, len(types))
+	for idx, tp := range types {
+		backpack[idx] = Stack{Type: tp, Quantity: 1, Weight: weights[tp]}
+	}
+
+	return backpack
+}
+
+// Slots returns the number of slots used by the backpack.
+func (b Backpack) Slots() int {
+	return len(b)
+}
+
+// Weight returns the total weight of all items in the backpack.
+func (b Backpack) Weight() uint64 {
+	var total uint64
+
+	for _, stack := range b {
+		total += uint64(stack.Quantity) * uint64(stack.Weight)
+	}
+
+	return total
+}
+
+// Quantity returns the total quantity of the provided item type across all stacks.
+func (b Backpack) Quantity(it ItemType) uint32 {
+	var total uint32
+
+	for _, stack := range b {
+		if stack.Type == it {
+			total += stack.Quantity
+		}
+	}
+
+	return total
+}
+
+// Add places the quantity of the item in the backpack, first filling existing stacks of the same type up to the
+// item stack size and then opening new slots. The slot limit and carry capacity are enforced with a capacity of
+// zero indicating no weight limit. The backpack is unchanged if the full quantity does not fit.
+func (b Backpack) Add(item Item, quantity uint32, slots uint8, capacity uint32) (Backpack, error) {
+	if capacity > 0 && b.Weight()+uint64(quantity)*uint64(item.Weight) > uint64(capacity) {
+		return b, ErrTooHeavy
+	}
+
+	maxStack := item.MaxStack()
+	next := make(Backpack, len(b))
+	copy(next, b)
+
+	remaining := quantity
+
+	for idx := range next {
+		if remaining == 0 {
+			break
+		}
+
+		if next[idx].Type != item.Type || next[idx].Quantity >= maxStack {
+			continue
+		}
+
+		added := min(maxStack-next[idx].Quantity, remaining)
+		next[idx].Quantity += added
+		remaining -= added
+	}
+
+	var opened Backpack
+
+	for remaining > 0 {
+		added := min(maxStack, remaining)
+		opened = append(opened, Stack{Type: item.Type, Quantity: added, Weight: item.Weight})
+		remaining -= added
+	}
+
+	if len(next)+len(opened) > int(slots) {
+		return b, ErrNotEnoughSlots
+	}
+
+	return append(opened, next...), nil
+}
+
+// Remove takes the quantity of the item type out of the backpack starting with the most recently added stack.
+// Stacks that become empty free their slot. The backpack is unchanged if it does not hold the full quantity.
+func (b Backpack) Remove(it ItemType, quantity uint32) (Backpack, error) {
+	if b.Quantity(it) < quantity {
+		return b, ErrNotEnoughItems
+	}
+
+	next := make(Backpack, 0, len(b))
+	remaining := quantity
+
+	for _, stack := range b {
+		if stack.Type == it && remaining > 0 {
+			removed := min(stack.Quantity, remaining)
+			stack.Quantity -= removed
+			remaining -= removed
+
+			if stack.Quantity == 0 {
+				continue
+			}
+		}
+
+		next = append(next, stack)
+	}
+
+	return next, nil
+}
+
+// TruncateSlots keeps at most the provided number of slots starting with the most recently added stack.
+func (b Backpack) TruncateSlots(slots int) Backpack {
+	if slots < len(b) {
+		return b[:slots]
+	}
+
+	return b
+}
+
+// TruncateItems keeps at most the provided number of items starting with the most recently added stack. The last
+// kept stack is reduced to the items that remain.
+func (b Backpack) TruncateItems(items uint32) Backpack {
+	next := make(Backpack, 0, len(b))
+
+	for _, stack := range b {
+		if items == 0 {
+			break
+		}
+
+		stack.Quantity = min(stack.Quantity, items)
+		items -= stack.Quantity
+
+		next = append(next, stack)
+	}
+
+	return next
+}
@@ -0,0 +1,200 @@
+package components_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ciphermountain/deadenz/pkg/components"
+)
+
+func TestBackpack_Add(t *testing.T) {
+	t.Parallel()
+
+	sandwich := components.Item{Type: 3, Name: "a sandwich", StackSize: 5, Weight: 1}
+	sword := components.Item{Type: 5, Name: "a sword", Weight: 8}
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("no open slots should return error and not modify backpack", func(t *testing.T) {
+			t.Parallel()
+
+			backpack := components.Backpack{{Type: sword.Type, Quantity: 1, Weight: sword.Weight}}
+			newBackpack, err := backpack.Add(sword, 1, 1, 0)
+
+			require.ErrorIs(t, err, components.ErrNotEnoughSlots)
+			assert.Equal(t, backpack, newBackpack)
+		})
+
+		t.Run("full stack with no open slots should return error", func(t *testing.T) {
+			t.Parallel()
+
+			backpack := components.Backpack{{Type: sandwich.Type, Quantity: 5, Weight: sandwich.Weight}}
+			newBackpack, err := backpack.Add(sandwich, 1, 1, 0)
+
+			require.ErrorIs(t, err, components.ErrNotEnoughSlots)
+			assert.Equal(t, uint32(5), newBackpack[0].Quantity)
+		})
+
+		t.Run("exceeding carry capacity should return error", func(t *testing.T) {
+			t.Parallel()
+
+			backpack := components.Backpack{{Type: sword.Type, Quantity: 1, Weight: sword.Weight}}
+			newBackpack, err := backpack.Add(sword, 1, 10, 10)
+
+			require.ErrorIs(t, err, components.ErrTooHeavy)
+			assert.Equal(t, backpack, newBackpack)
+		})
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("stackable items share a slot", func(t *testing.T) {
+			t.Parallel()
+
+			backpack := components.Backpack{{Type: sandwich.Type, Quantity: 1, Weight: sandwich.Weight}}
+			newBackpack, err := backpack.Add(sandwich, 1, 1, 0)
+
+			require.NoError(t, err)
+			require.Equal(t, 1, newBackpack.Slots())
+
+			assert.Equal(t, uint32(2), newBackpack.Quantity(sandwich.Type))
+			assert.Equal(t, uint32(1), backpack[0].Quantity, "original backpack should not be modified")
+		})
+
+		t.Run("overflowing a stack opens a new slot first", func(t *testing.T) {
+			t.Parallel()
+
+			backpack := components.Backpack{{Type: sandwich.Type, Quantity: 4, Weight: sandwich.Weight}}
+			newBackpack, err := backpack.Add(sandwich, 3, 2, 0)
+
+			require.NoError(t, err)
+			require.Equal(t, 2, newBackpack.Slots())
+
+			assert.Equal(t, uint32(2), newBackpack[0].Quantity)
+			assert.Equal(t, uint32(5), newBackpack[1].Quantity)
+			assert.Equal(t, uint64(7), newBackpack.Weight())
+		})
+
+		t.Run("non-stackable items use one slot each", func(t *testing.T) {
+			t.Parallel()
+
+			newBackpack, err := components.Backpack{}.Add(sword, 2, 2, 16)
+
+			require.NoError(t, err)
+			assert.Equal(t, 2, newBackpack.Slots())
+			assert.Equal(t, uint64(16), newBackpack.Weight())
+		})
+
+		t.Run("flat backpacks migrate to one slot per item", func(t *testing.T) {
+			t.Parallel()
+
+			backpack := components.NewBackpackFromItems([]components.ItemType{3, 3, 5}, nil)
+
+			assert.Equal(t, 3, backpack.Slots())
+			assert.Equal(t, uint32(2), backpack.Quantity(3))
+		})
+
+		t.Run("migrated backpacks weigh the same as backpacks built item by item", func(t *testing.T) {
+			t.Parallel()
+
+			items := []components.Item{
+				{Type: 3, Weight: 4},
+				{Type: 5, Weight: 7},
+			}
+
+			var (
+				built components.Backpack
+				err   error
+			)
+
+			for _, tp := range []components.ItemType{5, 3, 3} {
+				for _, item := range items {
+					if item.Type == tp {
+						built, err = built.Add(item, 1, 10, 0)
+
+						require.NoError(t, err)
+					}
+				}
+			}
+
+			migrated := components.NewBackpackFromItems([]components.ItemType{3, 3, 5}, items)
+
+			assert.Equal(t, built.Weight(), migrated.Weight())
+			assert.Equal(t, uint64(15), migrated.Weight())
+		})
+	})
+}
+
+func TestBackpack_Remove(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("removing more than the backpack holds should return error and not modify backpack", func(t *testing.T) {
+			t.Parallel()
+
+			backpack := components.Backpack{{Type: 4, Quantity: 2}}
+			newBackpack, err := backpack.Remove(4, 3)
+
+			require.ErrorIs(t, err, components.ErrNotEnoughItems)
+			assert.Equal(t, backpack, newBackpack)
+		})
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("removal spans stacks and frees empty slots", func(t *testing.T) {
+			t.Parallel()
+
+			backpack := components.Backpack{{Type: 4, Quantity: 2}, {Type: 5, Quantity: 1}, {Type: 4, Quantity: 20}}
+			newBackpack, err := backpack.Remove(4, 5)
+
+			require.NoError(t, err)
+			require.Equal(t, 2, newBackpack.Slots())
+
+			assert.Equal(t, uint32(17), newBackpack.Quantity(4))
+			assert.Equal(t, uint32(22), backpack.Quantity(4), "original backpack should not be modified")
+		})
+	})
+}
+
+func TestBackpack_TruncateItems(t *testing.T) {
+	t.Parallel()
+
+	t.Run("items are counted across stacks and the last stack is split", func(t *testing.T) {
+		t.Parallel()
+
+		backpack := components.Backpack{{Type: 4, Quantity: 6}, {Type: 5, Quantity: 3}, {Type: 6, Quantity: 2}}
+		truncated := backpack.TruncateItems(8)
+
+		assert.Equal(t, components.Backpack{{Type: 4, Quantity: 6}, {Type: 5, Quantity: 2}}, truncated)
+		assert.Equal(t, uint32(3), backpack[1].Quantity, "original backpack should not be modified")
+	})
+
+	t.Run("backpack with fewer items is kept", func(t *testing.T) {
+		t.Parallel()
+
+		backpack := components.Backpack{{Type: 4, Quantity: 2}}
+
+		assert.Equal(t, backpack, backpack.TruncateItems(10))
+	})
+}
+
+func TestBackpackLimitMutator(t *testing.T) {
+	t.Parallel()
+
+	profile := &components.Profile{
+		BackpackLimit: 5,
+		Backpack:      components.Backpack{{Type: 4, Quantity: 6}, {Type: 5, Quantity: 3}},
+	}
+	newProfile := components.BackpackLimitMutator(1)(profile)
+
+	assert.Equal(t, uint8(1), newProfile.BackpackLimit)
+	assert.Equal(t, components.Backpack{{Type: 4, Quantity: 6}}, newProfile.Backpack, "limit counts slots")
+}
@@ -0,0 +1,101 @@
+package components
+
+import "sort"
+
+// ProfileDiff lists the changes between two versions of a profile. Numeric values are the change from the
+// original to the updated profile.
+type ProfileDiff struct {
+	XP       int64
+	Currency int64
+	Stats    Stats
+	// Backpack is the change in quantity for each item type that changed, ordered by item type.
+	Backpack []QuantityChange
+	// ActiveItemChanged indicates that ActiveItem holds the new active item, which is nil when it was removed.
+	ActiveItemChanged bool
+	ActiveItem        *ItemType
+	// CharacterChanged indicates that Character holds the new character, which is nil when it died.
+	CharacterChanged bool
+	Character        *Character
+}
+
+// QuantityChange is the change in quantity of an item type.
+type QuantityChange struct {
+	Type     ItemType
+	Quantity int64
+}
+
+// DiffProfiles reports the changes from the original to the updated profile.
+func DiffProfiles(original, updated *Profile) ProfileDiff {
+	if original == nil {
+		original = &Profile{}
+	}
+
+	if updated == nil {
+		updated = &Profile{}
+	}
+
+	diff := ProfileDiff{
+		XP:       int64(updated.XP) - int64(original.XP),
+		Currency: int64(updated.Currency) - int64(original.Currency),
+		Stats: Stats{
+			Wit:   updated.Stats.Wit - original.Stats.Wit,
+			Skill: updated.Stats.Skill - original.Stats.Skill,
+			Humor: updated.Stats.Humor - original.Stats.Humor,
+		},
+		Backpack: diffBackpacks(original.Backpack, updated.Backpack),
+	}
+
+	if !equalPtr(original.ActiveItem, updated.ActiveItem) {
+		diff.ActiveItemChanged = true
+		diff.ActiveItem = updated.ActiveItem
+	}
+
+	if !equalPtr(original.Active, updated.Active) {
+		diff.CharacterChanged = true
+		diff.Character = updated.Active
+	}
+
+	return diff
+}
+
+// IsEmpty indicates that nothing in the profile changed.
+func (d ProfileDiff) IsEmpty() bool {
+	return d.XP == 0 &&
+		d.Currency == 0 &&
+		d.Stats == Stats{} &&
+		len(d.Backpack) == 0 &&
+		!d.ActiveItemChanged &&
+		!d.CharacterChanged
+}
+
+func diffBackpacks(original, updated Backpack) []QuantityChange {
+	changes := make(map[ItemType]int64)
+
+	for _, stack := range original {
+		changes[stack.Type] -= int64(stack.Quantity)
+	}
+
+	for _, stack := range updated {
+		changes[stack.Type] += int64(stack.Quantity)
+	}
+
+	var diff []QuantityChange
+
+	for tp, quantity := range changes {
+		if quantity != 0 {
+			diff = append(diff, QuantityChange{Type: tp, Quantity: quantity})
+		}
+	}
+
+	sort.Slice(diff, func(i, j int) bool { return diff[i].Type < diff[j].Type })
+
+	return diff
+}
+
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
@@ -13,4 +13,8 @@ const (
 	EventTypeFind         EventType = "find"
 	EventTypeMutation     EventType = "mutation"
 	EventTypeSpawnin      EventType = "spawnin"
+	EventTypeCraft        EventType = "craft"
+	EventTypeRest         EventType = "rest"
+	EventTypeLevelUp      EventType = "level_up"
+	EventTypeXPMilestone  EventType = "xp_milestone"
 )
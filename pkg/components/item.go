@@ -12,6 +12,11 @@ type Item struct {
 	Type      ItemType
 	Name      string
 	Findable  bool
+	StackSize uint32
+	Weight    uint32
+	Rest      uint32
+	// Rare items are announced to the multiverse when found.
+	Rare      bool
 	Usability *Usability
 	Mutators  []MutatorFunc
 }
@@ -35,6 +40,21 @@ func (i Item) Mutate(profile *Profile) *Profile {
 	return profile
 }
 
+// MaxStack returns the number of items that can share a single backpack slot. Items without a defined stack size
+// each occupy their own slot.
+func (i Item) MaxStack() uint32 {
+	if i.StackSize == 0 {
+		return 1
+	}
+
+	return i.StackSize
+}
+
+// IsFood indicates the item can be eaten while resting to recover walks.
+func (i Item) IsFood() bool {
+	return i.Rest > 0
+}
+
 func (i Item) IsUsable() bool {
 	return i.Usability != nil
 }
@@ -71,16 +91,9 @@ func (i UsableItem) ImprovesWalking() bool {
 	return i.item.Usability.ImprovesWalking
 }
 
+// ModifyBackpackContents keeps as many items as the item saves, counting every item of a stack, and drops the rest.
 func (i UsableItem) ModifyBackpackContents(profile *Profile) *Profile {
-	limit := i.item.Usability.SaveBackpackItems
-
-	if limit > profile.BackpackLimit {
-		limit = profile.BackpackLimit
-	}
-
-	if int(limit) < len(profile.Backpack) {
-		profile.Backpack = profile.Backpack[:limit]
-	}
+	profile.Backpack = profile.Backpack.TruncateItems(uint32(i.item.Usability.SaveBackpackItems))
 
 	return profile
 }
@@ -113,18 +126,25 @@ func MutateHumorBy(val int) MutatorFunc {
 	}
 }
 
+// BackpackLimitMutator sets the number of slots in the backpack. The limit counts slots rather than items, like
+// the backpack limit itself, so stacks that no longer fit are dropped whole.
 func BackpackLimitMutator(limit uint8) MutatorFunc {
 	return func(profile *Profile) *Profile {
-		if len(profile.Backpack) > int(limit) {
-			profile.Backpack = profile.Backpack[:limit]
-		}
-
+		profile.Backpack = profile.Backpack.TruncateSlots(int(limit))
 		profile.BackpackLimit = uint8(limit)
 
 		return profile
 	}
 }
 
+func CarryCapacityMutator(capacity uint32) MutatorFunc {
+	return func(profile *Profile) *Profile {
+		profile.CarryCapacity = capacity
+
+		return profile
+	}
+}
+
 func DefaultEfficiency(_ Stats) int {
 	return 1
 }
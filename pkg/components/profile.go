@@ -11,7 +11,8 @@ type Profile struct {
 	Active        *Character
 	ActiveItem    *ItemType
 	BackpackLimit uint8
-	Backpack      []ItemType
+	CarryCapacity uint32
+	Backpack      Backpack
 	Stats         Stats
 	Limits        *Limits
 }
@@ -25,4 +26,53 @@ type Stats struct {
 type Limits struct {
 	LastWalk  time.Time
 	WalkCount uint64
+	// Commands holds rate limit usage keyed by command name.
+	Commands map[string]CommandLimit
+	// LastRest is the time of the last rest taken without payment.
+	LastRest time.Time
+}
+
+// CommandLimit is the rate limit usage of a single command. Last is the time the count was last changed.
+type CommandLimit struct {
+	Last  time.Time
+	Count uint64
+}
+
+// Clone returns a deep copy of the profile that shares no memory with the original.
+func (p *Profile) Clone() *Profile {
+	if p == nil {
+		return nil
+	}
+
+	clone := *p
+
+	if p.Active != nil {
+		active := *p.Active
+		clone.Active = &active
+	}
+
+	if p.ActiveItem != nil {
+		activeItem := *p.ActiveItem
+		clone.ActiveItem = &activeItem
+	}
+
+	if p.Backpack != nil {
+		clone.Backpack = make(Backpack, len(p.Backpack))
+		copy(clone.Backpack, p.Backpack)
+	}
+
+	if p.Limits != nil {
+		limits := *p.Limits
+
+		if p.Limits.Commands != nil {
+			limits.Commands = make(map[string]CommandLimit, len(p.Limits.Commands))
+			for name, limit := range p.Limits.Commands {
+				limits.Commands[name] = limit
+			}
+		}
+
+		clone.Limits = &limits
+	}
+
+	return &clone
 }
@@ -0,0 +1,91 @@
+package components_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ciphermountain/deadenz/pkg/components"
+)
+
+func TestProfile_Clone(t *testing.T) {
+	t.Parallel()
+
+	item := components.ItemType(4)
+	profile := &components.Profile{
+		XP:         5,
+		Active:     &components.Character{Name: "a zombie"},
+		ActiveItem: &item,
+		Backpack:   components.Backpack{{Type: 4, Quantity: 1}},
+		Limits: &components.Limits{
+			WalkCount: 2,
+			Commands:  map[string]components.CommandLimit{"craft": {Count: 1, Last: time.Now()}},
+		},
+	}
+
+	clone := profile.Clone()
+
+	require.Equal(t, profile, clone)
+
+	clone.Active.Name = "a ghost"
+	*clone.ActiveItem = 5
+	clone.Backpack[0].Quantity = 3
+	clone.Limits.WalkCount = 10
+	clone.Limits.Commands["craft"] = components.CommandLimit{Count: 4}
+
+	assert.Equal(t, "a zombie", profile.Active.Name)
+	assert.Equal(t, components.ItemType(4), *profile.ActiveItem)
+	assert.Equal(t, uint32(1), profile.Backpack[0].Quantity)
+	assert.Equal(t, uint64(2), profile.Limits.WalkCount)
+	assert.Equal(t, uint64(1), profile.Limits.Commands["craft"].Count)
+	assert.Nil(t, (*components.Profile)(nil).Clone())
+}
+
+func TestDiffProfiles(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unchanged profile should be empty", func(t *testing.T) {
+		t.Parallel()
+
+		profile := &components.Profile{XP: 4, Active: &components.Character{Name: "a zombie"}}
+
+		assert.True(t, components.DiffProfiles(profile, profile.Clone()).IsEmpty())
+	})
+
+	t.Run("changes should be listed", func(t *testing.T) {
+		t.Parallel()
+
+		item := components.ItemType(9)
+		original := &components.Profile{
+			XP:       4,
+			Currency: 10,
+			Active:   &components.Character{Name: "a zombie"},
+			Backpack: components.Backpack{{Type: 3, Quantity: 2}, {Type: 5, Quantity: 1}},
+		}
+		updated := &components.Profile{
+			XP:         6,
+			Currency:   7,
+			ActiveItem: &item,
+			Stats:      components.Stats{Wit: 1},
+			Backpack:   components.Backpack{{Type: 7, Quantity: 1}, {Type: 3, Quantity: 1}},
+		}
+
+		diff := components.DiffProfiles(original, updated)
+
+		assert.False(t, diff.IsEmpty())
+		assert.Equal(t, int64(2), diff.XP)
+		assert.Equal(t, int64(-3), diff.Currency)
+		assert.Equal(t, components.Stats{Wit: 1}, diff.Stats)
+		assert.Equal(t, []components.QuantityChange{
+			{Type: 3, Quantity: -1},
+			{Type: 5, Quantity: -1},
+			{Type: 7, Quantity: 1},
+		}, diff.Backpack)
+		assert.True(t, diff.ActiveItemChanged)
+		assert.Equal(t, &item, diff.ActiveItem)
+		assert.True(t, diff.CharacterChanged)
+		assert.Nil(t, diff.Character)
+	})
+}
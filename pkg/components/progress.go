@@ -0,0 +1,32 @@
+package components
+
+// LevelXPStep is the additional xp required for each level over the previous one. Reaching level 2 requires
+// LevelXPStep xp, level 3 requires three times that, level 4 six times, and so on.
+const LevelXPStep uint = 50
+
+// XPMilestones are the xp totals that are celebrated when a player passes them.
+var XPMilestones = []uint{1000, 5000, 10000, 25000, 50000, 100000}
+
+// Level returns the level reached with the provided xp starting at level 1.
+func Level(xp uint) uint {
+	level := uint(1)
+
+	for required := LevelXPStep; xp >= required; required += LevelXPStep * level {
+		level++
+	}
+
+	return level
+}
+
+// PassedMilestones returns the milestones passed when xp increases from before to after.
+func PassedMilestones(before, after uint) []uint {
+	var passed []uint
+
+	for _, milestone := range XPMilestones {
+		if before < milestone && after >= milestone {
+			passed = append(passed, milestone)
+		}
+	}
+
+	return passed
+}
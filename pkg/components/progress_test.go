@@ -0,0 +1,25 @@
+package components_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ciphermountain/deadenz/pkg/components"
+)
+
+func TestLevel(t *testing.T) {
+	t.Parallel()
+
+	for xp, level := range map[uint]uint{0: 1, 49: 1, 50: 2, 149: 2, 150: 3, 300: 4} {
+		assert.Equal(t, level, components.Level(xp), "xp %d", xp)
+	}
+}
+
+func TestPassedMilestones(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, components.PassedMilestones(1000, 4999))
+	assert.Equal(t, []uint{1000}, components.PassedMilestones(999, 1000))
+	assert.Equal(t, []uint{1000, 5000}, components.PassedMilestones(900, 6000))
+}
@@ -0,0 +1,30 @@
+package components
+
+type RecipeType uint64
+
+// Ingredient is a quantity of a single item type used as either an input or an output of a recipe.
+type Ingredient struct {
+	Item     ItemType
+	Quantity uint32
+}
+
+// Recipe converts a set of backpack items into a new item. Requires is optional and defines the minimum stats
+// a profile must have to craft the recipe.
+type Recipe struct {
+	Type     RecipeType
+	Name     string
+	Inputs   []Ingredient
+	Output   Ingredient
+	Requires *Stats
+}
+
+// MeetsRequirements indicates whether the provided stats are at or above the recipe stat requirements.
+func (r Recipe) MeetsRequirements(stats Stats) bool {
+	if r.Requires == nil {
+		return true
+	}
+
+	return stats.Wit >= r.Requires.Wit &&
+		stats.Skill >= r.Requires.Skill &&
+		stats.Humor >= r.Requires.Humor
+}
@@ -0,0 +1,98 @@
+package deadenz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ciphermountain/deadenz/pkg/components"
+	"github.com/ciphermountain/deadenz/pkg/events"
+)
+
+var (
+	ErrUnknownRecipe      = errors.New("unknown recipe")
+	ErrMissingIngredients = errors.New("missing ingredients for recipe")
+	ErrRecipeRequirements = errors.New("stats do not meet recipe requirements")
+)
+
+// Craft consumes the inputs of a recipe from the profile backpack and adds the recipe output. Events emitted
+// include a craft event. The profile is not modified if any input is missing, the profile stats do not meet the
+// recipe requirements, or the output does not fit in the backpack.
+func Craft(profile *components.Profile, recipeType components.RecipeType, loader Loader) (*components.Profile, []components.Event, error) {
+	return CraftCtx(context.Background(), profile, recipeType, loader)
+}
+
+// CraftCtx is Craft with a context that is passed to the loader.
+func CraftCtx(
+	ctx context.Context,
+	profile *components.Profile,
+	recipeType components.RecipeType,
+	loader Loader,
+) (*components.Profile, []components.Event, error) {
+	var recipes []components.Recipe
+	if err := loader.LoadCtx(ctx, &recipes); err != nil {
+		return profile, nil, err
+	}
+
+	var items []components.Item
+	if err := loader.LoadCtx(ctx, &items); err != nil {
+		return profile, nil, err
+	}
+
+	recipe, ok := findRecipe(recipes, recipeType)
+	if !ok {
+		return profile, nil, ErrUnknownRecipe
+	}
+
+	output, ok := itemByType(items, recipe.Output.Item)
+	if !ok {
+		return profile, nil, fmt.Errorf("%w: output item %d not found", ErrUnknownRecipe, recipe.Output.Item)
+	}
+
+	if !recipe.MeetsRequirements(profile.Stats) {
+		return profile, nil, ErrRecipeRequirements
+	}
+
+	backpack := profile.Backpack
+
+	for _, input := range recipe.Inputs {
+		var err error
+
+		if backpack, err = backpack.Remove(input.Item, input.Quantity); err != nil {
+			return profile, nil, fmt.Errorf("%w: %w", ErrMissingIngredients, err)
+		}
+	}
+
+	backpack, err := backpack.Add(output, recipe.Output.Quantity, profile.BackpackLimit, profile.CarryCapacity)
+	if err != nil {
+		return profile, nil, fmt.Errorf("%w: %w", ErrBackpackTooSmall, err)
+	}
+
+	profile.Backpack = backpack
+
+	return profile, []components.Event{events.NewCraftEvent(recipe, output)}, nil
+}
+
+func findRecipe(recipes []components.Recipe, recipeType components.RecipeType) (components.Recipe, bool) {
+	for _, recipe := range recipes {
+		if recipe.Type == recipeType {
+			return recipe, true
+		}
+	}
+
+	return components.Recipe{}, false
+}
+
+func itemByType(items []components.Item, itemType components.ItemType) (components.Item, bool) {
+	for _, item := range items {
+		if item.Type == itemType {
+			return item, true
+		}
+	}
+
+	return components.Item{}, false
+}
+
+func craftCommand(ctx context.Context, profile *components.Profile, loader Loader, conf CommandConfig) (*components.Profile, []components.Event, error) {
+	return CraftCtx(ctx, profile, conf.Recipe, loader)
+}
@@ -0,0 +1,202 @@
+package deadenz_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	deadenz "github.com/ciphermountain/deadenz/pkg"
+	"github.com/ciphermountain/deadenz/pkg/components"
+	"github.com/ciphermountain/deadenz/pkg/events"
+)
+
+func TestCraft(t *testing.T) {
+	t.Parallel()
+
+	wood := components.Item{Type: 1, Name: "wood", StackSize: 5, Weight: 1}
+	rock := components.Item{Type: 2, Name: "rock", Weight: 2}
+	axe := components.Item{Type: 3, Name: "axe", Weight: 5}
+
+	loader := craftLoader{
+		items: []components.Item{wood, rock, axe},
+		recipes: []components.Recipe{
+			{
+				Type:   1,
+				Name:   "axe",
+				Inputs: []components.Ingredient{{Item: wood.Type, Quantity: 2}, {Item: rock.Type, Quantity: 1}},
+				Output: components.Ingredient{Item: axe.Type, Quantity: 1},
+			},
+			{
+				Type:     2,
+				Name:     "fine axe",
+				Inputs:   []components.Ingredient{{Item: wood.Type, Quantity: 1}},
+				Output:   components.Ingredient{Item: axe.Type, Quantity: 1},
+				Requires: &components.Stats{Skill: 3},
+			},
+			{
+				Type:   3,
+				Name:   "missing output",
+				Inputs: []components.Ingredient{{Item: wood.Type, Quantity: 1}},
+				Output: components.Ingredient{Item: 99, Quantity: 1},
+			},
+		},
+	}
+
+	newProfile := func(limit uint8, backpack ...components.Stack) *components.Profile {
+		return &components.Profile{
+			BackpackLimit: limit,
+			Backpack:      append(components.Backpack{}, backpack...),
+		}
+	}
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		// the axe weighs more than the consumed ingredients free up
+		tooHeavy := newProfile(5,
+			components.Stack{Type: wood.Type, Quantity: 3, Weight: wood.Weight},
+			components.Stack{Type: rock.Type, Quantity: 1, Weight: rock.Weight},
+		)
+		tooHeavy.CarryCapacity = 5
+
+		tests := []struct {
+			name    string
+			recipe  components.RecipeType
+			profile *components.Profile
+			err     error
+		}{
+			{
+				name:    "unknown recipe",
+				recipe:  10,
+				profile: newProfile(5, components.Stack{Type: wood.Type, Quantity: 2, Weight: wood.Weight}),
+				err:     deadenz.ErrUnknownRecipe,
+			},
+			{
+				name:    "recipe output is not an item",
+				recipe:  3,
+				profile: newProfile(5, components.Stack{Type: wood.Type, Quantity: 2, Weight: wood.Weight}),
+				err:     deadenz.ErrUnknownRecipe,
+			},
+			{
+				name:   "missing ingredients",
+				recipe: 1,
+				profile: newProfile(5,
+					components.Stack{Type: wood.Type, Quantity: 1, Weight: wood.Weight},
+					components.Stack{Type: rock.Type, Quantity: 1, Weight: rock.Weight},
+				),
+				err: deadenz.ErrMissingIngredients,
+			},
+			{
+				name:    "unmet requirements",
+				recipe:  2,
+				profile: newProfile(5, components.Stack{Type: wood.Type, Quantity: 1, Weight: wood.Weight}),
+				err:     deadenz.ErrRecipeRequirements,
+			},
+			{
+				name:    "output does not fit the backpack",
+				recipe:  1,
+				profile: tooHeavy,
+				err:     deadenz.ErrBackpackTooSmall,
+			},
+		}
+
+		for _, test := range tests {
+			test := test
+
+			t.Run(test.name, func(t *testing.T) {
+				t.Parallel()
+
+				before := append(components.Backpack{}, test.profile.Backpack...)
+				profile, evts, err := deadenz.Craft(test.profile, test.recipe, loader)
+
+				require.ErrorIs(t, err, test.err)
+				assert.Empty(t, evts)
+
+				// ingredients are only consumed on success
+				assert.Equal(t, before, profile.Backpack)
+			})
+		}
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		profile := newProfile(2,
+			components.Stack{Type: wood.Type, Quantity: 3, Weight: wood.Weight},
+			components.Stack{Type: rock.Type, Quantity: 1, Weight: rock.Weight},
+		)
+
+		profile, evts, err := deadenz.Craft(profile, 1, loader)
+
+		require.NoError(t, err)
+		require.Len(t, evts, 1)
+
+		assert.Equal(t, uint32(1), profile.Backpack.Quantity(wood.Type))
+		assert.Equal(t, uint32(0), profile.Backpack.Quantity(rock.Type))
+		assert.Equal(t, uint32(1), profile.Backpack.Quantity(axe.Type))
+		assert.Equal(t, uint64(wood.Weight+axe.Weight), profile.Backpack.Weight())
+		assert.Equal(t, events.NewCraftEvent(loader.recipes[0], axe), evts[0])
+	})
+}
+
+func TestCraftCommand(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("invalid recipe argument should return error", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := deadenz.DecodeCommand(deadenz.CraftCommandType, map[string]string{"recipe": "axe"})
+
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("recipe survives the wire", func(t *testing.T) {
+			t.Parallel()
+
+			args, err := deadenz.EncodeCommand(deadenz.CraftCommandType, deadenz.WithRecipe(2))
+			require.NoError(t, err)
+
+			opts, err := deadenz.DecodeCommand(deadenz.CraftCommandType, args)
+			require.NoError(t, err)
+
+			var conf deadenz.CommandConfig
+			for _, opt := range opts {
+				opt(&conf)
+			}
+
+			assert.Equal(t, components.RecipeType(2), conf.Recipe)
+		})
+	})
+}
+
+type craftLoader struct {
+	recipes []components.Recipe
+	items   []components.Item
+}
+
+func (l craftLoader) Load(value any) error {
+	return l.LoadCtx(context.Background(), value)
+}
+
+func (l craftLoader) LoadCtx(_ context.Context, value any) error {
+	switch val := value.(type) {
+	case *[]components.Recipe:
+		*val = l.recipes
+	case *[]components.Item:
+		*val = l.items
+	default:
+		return errors.New("unexpected asset")
+	}
+
+	return nil
+}
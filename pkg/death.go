@@ -0,0 +1,35 @@
+package deadenz
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ciphermountain/deadenz/pkg/components"
+	"github.com/ciphermountain/deadenz/pkg/events"
+)
+
+var ErrDeathNotApplicable = errors.New("death does not apply to the active character")
+
+// MultiverseDeath is the death of every character of a type reported by the multiverse along with the message of
+// the player whose character died.
+type MultiverseDeath struct {
+	Character components.CharacterType
+	Message   string
+}
+
+// ApplyMultiverseDeath kills the active character when it is of the type that died in the multiverse. The death is
+// emitted as a die mutation event so that death middleware applies to it as it does for deaths while walking. An
+// error is returned if the profile is not playing the character type.
+func ApplyMultiverseDeath(profile *components.Profile, death MultiverseDeath) (*components.Profile, []components.Event, error) {
+	if profile.Active == nil || profile.Active.Type != death.Character {
+		return profile, nil, ErrDeathNotApplicable
+	}
+
+	profile.Active = nil
+
+	return profile, []components.Event{events.NewDieMutationEvent(death.Message)}, nil
+}
+
+func multiverseDeathCommand(_ context.Context, profile *components.Profile, _ Loader, conf CommandConfig) (*components.Profile, []components.Event, error) {
+	return ApplyMultiverseDeath(profile, conf.Death)
+}
@@ -0,0 +1,51 @@
+package deadenz_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	deadenz "github.com/ciphermountain/deadenz/pkg"
+	"github.com/ciphermountain/deadenz/pkg/components"
+	"github.com/ciphermountain/deadenz/pkg/events"
+)
+
+func TestApplyMultiverseDeath(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("deaths of other characters should not apply", func(t *testing.T) {
+			t.Parallel()
+
+			profile := &components.Profile{Active: &components.Character{Type: 2}}
+			_, _, err := deadenz.ApplyMultiverseDeath(profile, deadenz.MultiverseDeath{Character: 3})
+
+			require.ErrorIs(t, err, deadenz.ErrDeathNotApplicable)
+			assert.NotNil(t, profile.Active)
+		})
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("death is applied with the originating message", func(t *testing.T) {
+			t.Parallel()
+
+			profile := &components.Profile{Active: &components.Character{Type: 3}}
+			death := deadenz.WithMultiverseDeath(3, "you were eaten by a grue")
+
+			result, err := deadenz.RunWithMiddleware(context.Background(), deadenz.MultiverseDeathCommandType, profile, nil, nil, death)
+
+			require.NoError(t, err)
+			require.Len(t, result.Events, 1)
+
+			assert.Nil(t, result.Profile.Active)
+			assert.Equal(t, events.NewDieMutationEvent("you were eaten by a grue"), result.Events[0])
+			assert.Equal(t, deadenz.SpawninCommandType, result.DefaultCmd)
+		})
+	})
+}
@@ -0,0 +1,70 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ciphermountain/deadenz/pkg/components"
+)
+
+func NewCraftEvent(recipe components.Recipe, item components.Item) CraftEvent {
+	return CraftEvent{
+		Recipe:   recipe.Type,
+		Item:     item,
+		Quantity: recipe.Output.Quantity,
+	}
+}
+
+// CraftEvent is emitted when a recipe consumes backpack items and produces a new item.
+type CraftEvent struct {
+	Recipe   components.RecipeType
+	Item     components.Item
+	Quantity uint32
+}
+
+func (e CraftEvent) String() string {
+	if e.Quantity > 1 {
+		return fmt.Sprintf("you craft %d of %s", e.Quantity, e.Item.Name)
+	}
+
+	return fmt.Sprintf("you craft %s", e.Item.Name)
+}
+
+func (e CraftEvent) MarshalJSON() ([]byte, error) {
+	formatted := jsonCraftEvent{
+		Type:     string(components.EventTypeCraft),
+		Recipe:   uint64(e.Recipe),
+		Item:     uint64(e.Item.Type),
+		Name:     e.Item.Name,
+		Quantity: e.Quantity,
+	}
+
+	return json.Marshal(formatted)
+}
+
+func (e *CraftEvent) UnmarshalJSON(data []byte) error {
+	var formatted jsonCraftEvent
+
+	if err := json.Unmarshal(data, &formatted); err != nil {
+		return err
+	}
+
+	*e = CraftEvent{
+		Recipe: components.RecipeType(formatted.Recipe),
+		Item: components.Item{
+			Type: components.ItemType(formatted.Item),
+			Name: formatted.Name,
+		},
+		Quantity: formatted.Quantity,
+	}
+
+	return nil
+}
+
+type jsonCraftEvent struct {
+	Type     string `json:"type"`
+	Recipe   uint64 `json:"recipe"`
+	Item     uint64 `json:"item"`
+	Name     string `json:"name"`
+	Quantity uint32 `json:"quantity"`
+}
@@ -20,14 +20,35 @@ func (e FindEvent) String() string {
 }
 
 func (e FindEvent) MarshalJSON() ([]byte, error) {
+	// mutators are functions that cannot be encoded and are left out of the item
+	type item struct {
+		Type      components.ItemType
+		Name      string
+		Findable  bool
+		StackSize uint32
+		Weight    uint32
+		Rest      uint32
+		Rare      bool
+		Usability *components.Usability
+	}
+
 	type event struct {
-		Type string          `json:"type"`
-		Item components.Item `json:"item"`
+		Type string `json:"type"`
+		Item item   `json:"item"`
 	}
 
 	formatted := event{
 		Type: string(components.EventTypeFind),
-		Item: e.Item,
+		Item: item{
+			Type:      e.Item.Type,
+			Name:      e.Item.Name,
+			Findable:  e.Item.Findable,
+			StackSize: e.Item.StackSize,
+			Weight:    e.Item.Weight,
+			Rest:      e.Item.Rest,
+			Rare:      e.Item.Rare,
+			Usability: e.Item.Usability,
+		},
 	}
 
 	return json.Marshal(formatted)
@@ -0,0 +1,92 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ciphermountain/deadenz/pkg/components"
+)
+
+func NewLevelUpEvent(level uint) LevelUpEvent {
+	return LevelUpEvent{Level: level}
+}
+
+// LevelUpEvent is emitted when a command earns enough xp to reach a new level.
+type LevelUpEvent struct {
+	Level uint
+}
+
+func (e LevelUpEvent) String() string {
+	return fmt.Sprintf("you reached level %d", e.Level)
+}
+
+func (e LevelUpEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonProgressEvent{
+		Type:  string(components.EventTypeLevelUp),
+		Value: e.Level,
+	})
+}
+
+func (e *LevelUpEvent) UnmarshalJSON(data []byte) error {
+	var formatted jsonProgressEvent
+
+	if err := json.Unmarshal(data, &formatted); err != nil {
+		return err
+	}
+
+	*e = LevelUpEvent{Level: formatted.Value}
+
+	return nil
+}
+
+func NewXPMilestoneEvent(xp uint) XPMilestoneEvent {
+	return XPMilestoneEvent{XP: xp}
+}
+
+// XPMilestoneEvent is emitted when a command earns enough xp to pass one of the xp milestones.
+type XPMilestoneEvent struct {
+	XP uint
+}
+
+func (e XPMilestoneEvent) String() string {
+	return fmt.Sprintf("you passed %d xp", e.XP)
+}
+
+func (e XPMilestoneEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonProgressEvent{
+		Type:  string(components.EventTypeXPMilestone),
+		Value: e.XP,
+	})
+}
+
+func (e *XPMilestoneEvent) UnmarshalJSON(data []byte) error {
+	var formatted jsonProgressEvent
+
+	if err := json.Unmarshal(data, &formatted); err != nil {
+		return err
+	}
+
+	*e = XPMilestoneEvent{XP: formatted.Value}
+
+	return nil
+}
+
+// ProgressEvents returns the level up and milestone events for an increase in xp from before to after.
+func ProgressEvents(before, after uint) []components.Event {
+	var evts []components.Event
+
+	if level := components.Level(after); level > components.Level(before) {
+		evts = append(evts, NewLevelUpEvent(level))
+	}
+
+	for _, milestone := range components.PassedMilestones(before, after) {
+		evts = append(evts, NewXPMilestoneEvent(milestone))
+	}
+
+	return evts
+}
+
+type jsonProgressEvent struct {
+	Type  string `json:"type"`
+	Value uint   `json:"value"`
+}
@@ -0,0 +1,92 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ciphermountain/deadenz/pkg/components"
+)
+
+func NewRestEvent(recovered uint) RestEvent {
+	return RestEvent{Recovered: recovered}
+}
+
+func NewPaidRestEvent(recovered, cost uint) RestEvent {
+	return RestEvent{Recovered: recovered, Cost: cost}
+}
+
+func NewFoodRestEvent(recovered uint, food components.Item) RestEvent {
+	return RestEvent{Recovered: recovered, Food: &food}
+}
+
+// RestEvent is emitted when a profile recovers walks. Cost is the currency paid and Food is the item eaten, if any.
+type RestEvent struct {
+	Recovered uint
+	Cost      uint
+	Food      *components.Item
+}
+
+func (e RestEvent) String() string {
+	switch {
+	case e.Food != nil:
+		return fmt.Sprintf("you eat %s and recover %s", e.Food.Name, walks(e.Recovered))
+	case e.Cost > 0:
+		return fmt.Sprintf("you pay %d tokens for a nap at an inn and recover %s", e.Cost, walks(e.Recovered))
+	default:
+		return fmt.Sprintf("you rest on a park bench and recover %s", walks(e.Recovered))
+	}
+}
+
+func (e RestEvent) MarshalJSON() ([]byte, error) {
+	formatted := jsonRestEvent{
+		Type:      string(components.EventTypeRest),
+		Recovered: e.Recovered,
+		Cost:      e.Cost,
+	}
+
+	if e.Food != nil {
+		food := uint64(e.Food.Type)
+		formatted.Food = &food
+		formatted.FoodName = e.Food.Name
+	}
+
+	return json.Marshal(formatted)
+}
+
+func (e *RestEvent) UnmarshalJSON(data []byte) error {
+	var formatted jsonRestEvent
+
+	if err := json.Unmarshal(data, &formatted); err != nil {
+		return err
+	}
+
+	*e = RestEvent{
+		Recovered: formatted.Recovered,
+		Cost:      formatted.Cost,
+	}
+
+	if formatted.Food != nil {
+		e.Food = &components.Item{
+			Type: components.ItemType(*formatted.Food),
+			Name: formatted.FoodName,
+		}
+	}
+
+	return nil
+}
+
+type jsonRestEvent struct {
+	Type      string  `json:"type"`
+	Recovered uint    `json:"recovered"`
+	Cost      uint    `json:"cost,omitempty"`
+	Food      *uint64 `json:"food,omitempty"`
+	FoodName  string  `json:"food_name,omitempty"`
+}
+
+func walks(count uint) string {
+	if count == 1 {
+		return "1 walk"
+	}
+
+	return fmt.Sprintf("%d walks", count)
+}
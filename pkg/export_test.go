@@ -0,0 +1,16 @@
+package deadenz
+
+// UnregisterCommand removes a command registered by a test and all of its names so that repeated test runs can
+// register it again.
+func UnregisterCommand(cmdType CommandType) {
+	commandsMu.Lock()
+	defer commandsMu.Unlock()
+
+	delete(commands, cmdType)
+
+	for name, registered := range commandNames {
+		if registered == cmdType {
+			delete(commandNames, name)
+		}
+	}
+}
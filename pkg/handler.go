@@ -0,0 +1,78 @@
+package deadenz
+
+import (
+	"context"
+
+	"github.com/ciphermountain/deadenz/pkg/components"
+)
+
+// Handler applies a command to a profile and returns the result.
+type Handler func(context.Context, CommandType, *components.Profile) (Result, error)
+
+// Middleware wraps a handler. A middleware can read the input and the result of the wrapped handler, time it,
+// retry it, or skip it entirely.
+type Middleware func(next Handler) Handler
+
+// Chain composes middleware into a single middleware where the first is the outermost.
+func Chain(middleware ...Middleware) Middleware {
+	return func(next Handler) Handler {
+		for idx := len(middleware) - 1; idx >= 0; idx-- {
+			next = middleware[idx](next)
+		}
+
+		return next
+	}
+}
+
+// PreRunMiddleware runs the pre-run function before the wrapped handler. Neither is called once the context is
+// done and the wrapped handler is not called if the pre-run function returns an error.
+func PreRunMiddleware(f PreRunCtxFunc) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, cmd CommandType, profile *components.Profile) (Result, error) {
+			if err := ctx.Err(); err != nil {
+				return Result{Profile: profile}, err
+			}
+
+			profile, err := f(ctx, cmd, profile)
+			if err != nil {
+				return Result{Profile: profile}, err
+			}
+
+			return next(ctx, cmd, profile)
+		}
+	}
+}
+
+// PostRunMiddleware runs the post-run function with the result of the wrapped handler as long as the wrapped
+// handler does not return an error.
+func PostRunMiddleware(f PostRunCtxFunc) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, cmd CommandType, profile *components.Profile) (Result, error) {
+			result, err := next(ctx, cmd, profile)
+			if err != nil {
+				return result, err
+			}
+
+			result.Profile, err = f(ctx, cmd, result.Profile, result.Events)
+
+			return result, err
+		}
+	}
+}
+
+// MiddlewareFromRunFuncs expresses pre-run and post-run functions as a middleware chain. Pre-run functions run in
+// order before the command and post-run functions run in order after it.
+func MiddlewareFromRunFuncs(preRun []PreRunCtxFunc, postRun []PostRunCtxFunc) []Middleware {
+	middleware := make([]Middleware, 0, len(preRun)+len(postRun))
+
+	for _, f := range preRun {
+		middleware = append(middleware, PreRunMiddleware(f))
+	}
+
+	// the first post-run function must be the innermost to run first
+	for idx := len(postRun) - 1; idx >= 0; idx-- {
+		middleware = append(middleware, PostRunMiddleware(postRun[idx]))
+	}
+
+	return middleware
+}
@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	deadenz "github.com/ciphermountain/deadenz/pkg"
+	"github.com/ciphermountain/deadenz/pkg/components"
+)
+
+func init() {
+	RegisterAround("profile_lock", newProfileLock)
+	RegisterAround("command_timer", newCommandTimer)
+}
+
+// ProfileLock serializes commands for the same profile UUID so that concurrent commands cannot interleave their
+// profile changes.
+func ProfileLock() deadenz.Middleware {
+	var (
+		mu    sync.Mutex
+		locks = make(map[string]*profileLock)
+	)
+
+	acquire := func(id string) *profileLock {
+		mu.Lock()
+		defer mu.Unlock()
+
+		lock, ok := locks[id]
+		if !ok {
+			lock = &profileLock{}
+			locks[id] = lock
+		}
+
+		lock.users++
+
+		return lock
+	}
+
+	release := func(id string, lock *profileLock) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		lock.users--
+
+		if lock.users == 0 {
+			delete(locks, id)
+		}
+	}
+
+	return func(next deadenz.Handler) deadenz.Handler {
+		return func(ctx context.Context, cmd deadenz.CommandType, profile *components.Profile) (deadenz.Result, error) {
+			if profile == nil {
+				return next(ctx, cmd, profile)
+			}
+
+			id := profile.UUID
+			lock := acquire(id)
+
+			lock.Lock()
+			defer func() {
+				lock.Unlock()
+				release(id, lock)
+			}()
+
+			return next(ctx, cmd, profile)
+		}
+	}
+}
+
+type profileLock struct {
+	sync.Mutex
+	users int
+}
+
+// CommandTimer reports the duration and error of every command to the observe function.
+func CommandTimer(observe func(deadenz.CommandType, time.Duration, error)) deadenz.Middleware {
+	return func(next deadenz.Handler) deadenz.Handler {
+		return func(ctx context.Context, cmd deadenz.CommandType, profile *components.Profile) (deadenz.Result, error) {
+			start := time.Now()
+			result, err := next(ctx, cmd, profile)
+
+			observe(cmd, time.Since(start), err)
+
+			return result, err
+		}
+	}
+}
+
+func newProfileLock(_ json.RawMessage, _ Dependencies) (deadenz.Middleware, error) {
+	return ProfileLock(), nil
+}
+
+func newCommandTimer(_ json.RawMessage, _ Dependencies) (deadenz.Middleware, error) {
+	return CommandTimer(func(cmd deadenz.CommandType, duration time.Duration, err error) {
+		if err != nil {
+			log.Printf("command %s failed after %s: %s", cmd, duration, err.Error())
+
+			return
+		}
+
+		log.Printf("command %s completed in %s", cmd, duration)
+	}), nil
+}
@@ -0,0 +1,122 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	deadenz "github.com/ciphermountain/deadenz/pkg"
+	"github.com/ciphermountain/deadenz/pkg/components"
+	"github.com/ciphermountain/deadenz/pkg/middleware"
+)
+
+func TestChain_Order(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+
+	record := func(name string) deadenz.Middleware {
+		return func(next deadenz.Handler) deadenz.Handler {
+			return func(ctx context.Context, cmd deadenz.CommandType, profile *components.Profile) (deadenz.Result, error) {
+				calls = append(calls, "before "+name)
+				result, err := next(ctx, cmd, profile)
+				calls = append(calls, "after "+name)
+
+				return result, err
+			}
+		}
+	}
+
+	pre := func(_ context.Context, _ deadenz.CommandType, profile *components.Profile) (*components.Profile, error) {
+		calls = append(calls, "pre")
+
+		return profile, nil
+	}
+
+	post := func(_ context.Context, _ deadenz.CommandType, profile *components.Profile, _ []components.Event) (*components.Profile, error) {
+		calls = append(calls, "post")
+
+		return profile, nil
+	}
+
+	handler := func(_ context.Context, _ deadenz.CommandType, profile *components.Profile) (deadenz.Result, error) {
+		calls = append(calls, "command")
+
+		return deadenz.Result{Profile: profile}, nil
+	}
+
+	chain := append(
+		[]deadenz.Middleware{record("outer"), record("inner")},
+		deadenz.MiddlewareFromRunFuncs([]deadenz.PreRunCtxFunc{pre}, []deadenz.PostRunCtxFunc{post})...)
+
+	_, err := deadenz.Chain(chain...)(handler)(context.Background(), deadenz.WalkCommandType, &components.Profile{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"before outer", "before inner", "pre", "command", "post", "after inner", "after outer",
+	}, calls)
+}
+
+func TestCommandTimer(t *testing.T) {
+	t.Parallel()
+
+	expected := errors.New("failed")
+
+	var (
+		observed deadenz.CommandType
+		err      error
+	)
+
+	timer := middleware.CommandTimer(func(cmd deadenz.CommandType, _ time.Duration, cmdErr error) {
+		observed = cmd
+		err = cmdErr
+	})
+
+	handler := timer(func(_ context.Context, _ deadenz.CommandType, _ *components.Profile) (deadenz.Result, error) {
+		return deadenz.Result{}, expected
+	})
+
+	_, result := handler(context.Background(), deadenz.CraftCommandType, &components.Profile{})
+
+	require.ErrorIs(t, result, expected)
+	assert.Equal(t, deadenz.CraftCommandType, observed)
+	assert.ErrorIs(t, err, expected)
+}
+
+func TestProfileLock(t *testing.T) {
+	t.Parallel()
+
+	var (
+		running int
+		maxSeen int
+		done    = make(chan struct{})
+	)
+
+	handler := middleware.ProfileLock()(func(_ context.Context, _ deadenz.CommandType, profile *components.Profile) (deadenz.Result, error) {
+		running++
+		maxSeen = max(maxSeen, running)
+
+		time.Sleep(time.Millisecond)
+
+		running--
+
+		return deadenz.Result{Profile: profile}, nil
+	})
+
+	for range 5 {
+		go func() {
+			_, _ = handler(context.Background(), deadenz.WalkCommandType, &components.Profile{UUID: "same"})
+			done <- struct{}{}
+		}()
+	}
+
+	for range 5 {
+		<-done
+	}
+
+	assert.Equal(t, 1, maxSeen, "commands for the same profile should not overlap")
+}
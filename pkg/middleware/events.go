@@ -1,16 +1,22 @@
 package middleware
 
 import (
+	"encoding/json"
+
 	deadenz "github.com/ciphermountain/deadenz/pkg"
 	"github.com/ciphermountain/deadenz/pkg/components"
 	"github.com/ciphermountain/deadenz/pkg/events"
 )
 
+func init() {
+	RegisterPostRun("death_active_item", newDeathActiveItemMiddleware)
+}
+
 // DeathActiveItemMiddleware applies the mutation of an active item as long as a death event exists and the
-// active item matches the provided item type. The active item is removed after the mutation is applied.
-func DeathActiveItemMiddleware(it components.ItemType, items ItemProvider) deadenz.PostRunFunc {
+// active item definition saves backpack items. The active item is removed after the mutation is applied.
+func DeathActiveItemMiddleware(items ItemProvider) deadenz.PostRunFunc {
 	return func(_ deadenz.CommandType, profile *components.Profile, evts []components.Event) (*components.Profile, error) {
-		if profile.ActiveItem == nil || *profile.ActiveItem != it {
+		if profile.ActiveItem == nil {
 			return profile, nil
 		}
 
@@ -20,7 +26,7 @@ func DeathActiveItemMiddleware(it components.ItemType, items ItemProvider) deade
 			switch evt.(type) {
 			case events.DieMutationEvent:
 				item, err := items.Item(*profile.ActiveItem)
-				if err != nil {
+				if err != nil || !savesBackpackItems(item) {
 					return profile, nil
 				}
 
@@ -45,3 +51,11 @@ func applyItemDeathEvent(profile *components.Profile, item *components.Item) *co
 
 	return profile
 }
+
+func savesBackpackItems(item *components.Item) bool {
+	return item.IsUsable() && item.Usability.SaveBackpackItems > 0
+}
+
+func newDeathActiveItemMiddleware(_ json.RawMessage, deps Dependencies) (deadenz.PostRunFunc, error) {
+	return DeathActiveItemMiddleware(deps.Items), nil
+}
@@ -0,0 +1,93 @@
+package middleware_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	deadenz "github.com/ciphermountain/deadenz/pkg"
+	"github.com/ciphermountain/deadenz/pkg/components"
+	"github.com/ciphermountain/deadenz/pkg/events"
+	"github.com/ciphermountain/deadenz/pkg/middleware"
+	"github.com/ciphermountain/deadenz/pkg/middleware/mocks"
+)
+
+func TestDeathActiveItemMiddleware(t *testing.T) {
+	t.Parallel()
+
+	death := []components.Event{events.NewDieMutationEvent("you die instantly")}
+
+	t.Run("item without saved backpack items is not applied", func(t *testing.T) {
+		t.Parallel()
+
+		it := components.ItemType(7)
+		item := components.Item{Type: it, Name: "giant scissors"}
+
+		mockItemProvider := mocks.NewMockItemProvider(t)
+		mockItemProvider.EXPECT().Item(it).Return(&item, nil)
+
+		profile := &components.Profile{
+			ActiveItem:    &it,
+			BackpackLimit: 10,
+			Backpack:      components.Backpack{{Type: 3, Quantity: 1}, {Type: 4, Quantity: 1}},
+		}
+		newProfile, err := middleware.DeathActiveItemMiddleware(mockItemProvider)(deadenz.WalkCommandType, profile, death)
+
+		require.NoError(t, err)
+		require.NotNil(t, newProfile.ActiveItem)
+		assert.Equal(t, 2, newProfile.Backpack.Slots())
+	})
+
+	t.Run("any item that saves backpack items is applied", func(t *testing.T) {
+		t.Parallel()
+
+		it := components.ItemType(99)
+		item := components.Item{
+			Type:      it,
+			Name:      "a safe",
+			Usability: &components.Usability{SaveBackpackItems: 1},
+			Mutators:  []components.MutatorFunc{components.MutateSkillBy(1)},
+		}
+
+		mockItemProvider := mocks.NewMockItemProvider(t)
+		mockItemProvider.EXPECT().Item(it).Return(&item, nil)
+
+		profile := &components.Profile{
+			ActiveItem:    &it,
+			BackpackLimit: 10,
+			Backpack:      components.Backpack{{Type: 3, Quantity: 1}, {Type: 4, Quantity: 1}},
+		}
+		newProfile, err := middleware.DeathActiveItemMiddleware(mockItemProvider)(deadenz.WalkCommandType, profile, death)
+
+		require.NoError(t, err)
+
+		assert.Nil(t, newProfile.ActiveItem)
+		assert.Equal(t, 1, newProfile.Backpack.Slots())
+		assert.Equal(t, 1, newProfile.Stats.Skill)
+	})
+
+	t.Run("saved backpack items count every item of a stack", func(t *testing.T) {
+		t.Parallel()
+
+		it := components.ItemType(99)
+		item := components.Item{
+			Type:      it,
+			Name:      "a locker",
+			Usability: &components.Usability{SaveBackpackItems: 10},
+		}
+
+		mockItemProvider := mocks.NewMockItemProvider(t)
+		mockItemProvider.EXPECT().Item(it).Return(&item, nil)
+
+		profile := &components.Profile{
+			ActiveItem:    &it,
+			BackpackLimit: 10,
+			Backpack:      components.Backpack{{Type: 3, Quantity: 8}, {Type: 4, Quantity: 5}, {Type: 5, Quantity: 1}},
+		}
+		newProfile, err := middleware.DeathActiveItemMiddleware(mockItemProvider)(deadenz.WalkCommandType, profile, death)
+
+		require.NoError(t, err)
+		assert.Equal(t, components.Backpack{{Type: 3, Quantity: 8}, {Type: 4, Quantity: 2}}, newProfile.Backpack)
+	})
+}
@@ -0,0 +1,10 @@
+package middleware
+
+// UnregisterPreRun removes a pre-run constructor registered by a test so that repeated test runs can register it
+// again.
+func UnregisterPreRun(name string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	delete(preRuns, name)
+}
@@ -0,0 +1,259 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	deadenz "github.com/ciphermountain/deadenz/pkg"
+	"github.com/ciphermountain/deadenz/pkg/components"
+)
+
+var (
+	ErrRateLimited   = errors.New("command used too much")
+	ErrBurstExceeded = errors.New("command used too many times in one request")
+)
+
+func init() {
+	RegisterPreRunCtx("command_limiter", newCommandLimiter)
+}
+
+// RateLimitError is returned when a command is limited and carries the time until the command is allowed again.
+type RateLimitError struct {
+	Command    deadenz.CommandType
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("%s: try again in %s", e.Err.Error(), e.RetryAfter.Round(time.Second))
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return e.Err
+}
+
+// RateLimit defines how often a command can be used. Each use is recovered after the regeneration duration and
+// a profile may hold up to capacity uses before the command is limited. Burst is the most uses a single request
+// may take, which bounds repeated commands without draining the whole capacity. Zero allows any number of uses
+// per request.
+type RateLimit struct {
+	Capacity     uint64
+	Regeneration time.Duration
+	Burst        uint64
+}
+
+// CommandLimiter applies the rate limit to a single command. Usage is tracked per command in the profile limits.
+func CommandLimiter(command deadenz.CommandType, limit RateLimit) deadenz.PreRunCtxFunc {
+	return commandLimiter(command, limit).preRun
+}
+
+// limiter is the token bucket behind every command limit. The rate limit is resolved for each profile and the
+// usage is read from and written to the profile limits by load and store.
+type limiter struct {
+	command deadenz.CommandType
+	limit   func(*components.Profile) RateLimit
+	err     error
+	load    func(*components.Limits) components.CommandLimit
+	store   func(*components.Limits, components.CommandLimit)
+}
+
+func commandLimiter(command deadenz.CommandType, limit RateLimit) limiter {
+	key := command.String()
+
+	return limiter{
+		command: command,
+		limit:   func(*components.Profile) RateLimit { return limit },
+		err:     ErrRateLimited,
+		load: func(limits *components.Limits) components.CommandLimit {
+			return limits.Commands[key]
+		},
+		store: func(limits *components.Limits, state components.CommandLimit) {
+			if limits.Commands == nil {
+				limits.Commands = make(map[string]components.CommandLimit)
+			}
+
+			limits.Commands[key] = state
+		},
+	}
+}
+
+func (l limiter) preRun(ctx context.Context, cmd deadenz.CommandType, profile *components.Profile) (*components.Profile, error) {
+	if cmd != l.command {
+		return profile, nil
+	}
+
+	if profile == nil {
+		return nil, ErrNilProfile
+	}
+
+	limit := l.limit(profile)
+	if limit.Burst > 0 && uint64(deadenz.RepeatStep(ctx)) >= limit.Burst {
+		return profile, fmt.Errorf("%w: %s", ErrBurstExceeded, cmd)
+	}
+
+	if profile.Limits == nil {
+		profile.Limits = &components.Limits{}
+	}
+
+	now := time.Now()
+	state := regenerate(l.load(profile.Limits), limit.Regeneration, now)
+	if state.Count >= limit.Capacity {
+		l.store(profile.Limits, state)
+
+		return profile, &RateLimitError{
+			Command:    l.command,
+			RetryAfter: limit.Regeneration*time.Duration(state.Count-limit.Capacity+1) - now.Sub(state.Last),
+			Err:        l.err,
+		}
+	}
+
+	if state.Count == 0 {
+		state.Last = now
+	}
+
+	state.Count++
+	l.store(profile.Limits, state)
+
+	return profile, nil
+}
+
+// regenerate stores the usage of the profile reduced by the uses recovered up to now.
+func (l limiter) regenerate(profile *components.Profile, now time.Time) {
+	if profile.Limits == nil {
+		return
+	}
+
+	l.store(profile.Limits, regenerate(l.load(profile.Limits), l.limit(profile).Regeneration, now))
+}
+
+// capacity computes the capacity of the command for a profile at a point in time.
+func (l limiter) capacity(profile *components.Profile, now time.Time) CommandStatus {
+	limit := l.limit(profile)
+	status := CommandStatus{
+		Command:   l.command,
+		Capacity:  limit.Capacity,
+		Remaining: limit.Capacity,
+		NextUse:   now,
+	}
+
+	if profile.Limits == nil {
+		return status
+	}
+
+	state := regenerate(l.load(profile.Limits), limit.Regeneration, now)
+	if state.Count >= limit.Capacity {
+		status.Remaining = 0
+		status.NextUse = state.Last.Add(limit.Regeneration * time.Duration(state.Count-limit.Capacity+1))
+
+		return status
+	}
+
+	status.Remaining = limit.Capacity - state.Count
+
+	return status
+}
+
+// regenerate recovers uses for every full regeneration period since the last change. Partial progress toward
+// the next recovered use is kept by advancing the last change time only by the recovered periods.
+func regenerate(state components.CommandLimit, regeneration time.Duration, now time.Time) components.CommandLimit {
+	if state.Count == 0 || regeneration <= 0 {
+		return state
+	}
+
+	recovered := uint64(now.Sub(state.Last) / regeneration)
+	if recovered >= state.Count {
+		return components.CommandLimit{Last: now}
+	}
+
+	return components.CommandLimit{
+		Last:  state.Last.Add(regeneration * time.Duration(recovered)),
+		Count: state.Count - recovered,
+	}
+}
+
+// CommandRateLimit is the rate limit configured for a single command.
+type CommandRateLimit struct {
+	Command deadenz.CommandType
+	Limit   RateLimit
+}
+
+// CommandLimitsFromConfig returns the rate limits of every configured command limiter in order. Invalid limiter
+// configurations are skipped since they fail when the chain is built.
+func CommandLimitsFromConfig(chain ChainConfig) []CommandRateLimit {
+	var limits []CommandRateLimit
+
+	for _, conf := range chain.PreRun {
+		if conf.Name != "command_limiter" {
+			continue
+		}
+
+		limit, err := commandLimiterParams(conf.Params)
+		if err != nil {
+			continue
+		}
+
+		limits = append(limits, limit)
+	}
+
+	return limits
+}
+
+// CommandStatus is the rate limit capacity of a command for a profile at a point in time.
+type CommandStatus struct {
+	Command deadenz.CommandType
+	// Capacity is the number of uses held before the command is limited.
+	Capacity uint64
+	// Remaining is the number of uses allowed before the profile must wait.
+	Remaining uint64
+	// NextUse is the earliest time the profile is allowed to use the command.
+	NextUse time.Time
+}
+
+// CommandCapacity computes the capacity of a command for a profile using the same rules as CommandLimiter.
+func CommandCapacity(command deadenz.CommandType, limit RateLimit, profile *components.Profile, now time.Time) CommandStatus {
+	return commandLimiter(command, limit).capacity(profile, now)
+}
+
+func commandLimiterParams(params json.RawMessage) (CommandRateLimit, error) {
+	var conf struct {
+		Command      string `json:"command"`
+		Capacity     uint64 `json:"capacity"`
+		Regeneration string `json:"regeneration"`
+		Burst        uint64 `json:"burst"`
+	}
+
+	if err := decodeParams(params, &conf); err != nil {
+		return CommandRateLimit{}, err
+	}
+
+	cmd, err := deadenz.ParseCommandType(conf.Command)
+	if err != nil {
+		return CommandRateLimit{}, err
+	}
+
+	regeneration, err := time.ParseDuration(conf.Regeneration)
+	if err != nil {
+		return CommandRateLimit{}, err
+	}
+
+	return CommandRateLimit{
+		Command: cmd,
+		Limit: RateLimit{
+			Capacity:     conf.Capacity,
+			Regeneration: regeneration,
+			Burst:        conf.Burst,
+		},
+	}, nil
+}
+
+func newCommandLimiter(params json.RawMessage, _ Dependencies) (deadenz.PreRunCtxFunc, error) {
+	conf, err := commandLimiterParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return CommandLimiter(conf.Command, conf.Limit), nil
+}
@@ -0,0 +1,149 @@
+package middleware_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	deadenz "github.com/ciphermountain/deadenz/pkg"
+	"github.com/ciphermountain/deadenz/pkg/components"
+	"github.com/ciphermountain/deadenz/pkg/middleware"
+)
+
+func TestCommandLimiter(t *testing.T) {
+	t.Parallel()
+
+	limiter := middleware.CommandLimiter(deadenz.CraftCommandType, middleware.RateLimit{
+		Capacity:     3,
+		Regeneration: 10 * time.Minute,
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("exceeding capacity should return rate limit error with retry", func(t *testing.T) {
+			t.Parallel()
+
+			profile := &components.Profile{
+				Limits: &components.Limits{
+					Commands: map[string]components.CommandLimit{
+						"craft": {Last: time.Now().Add(-4 * time.Minute), Count: 3},
+					},
+				},
+			}
+			_, err := limiter(context.Background(), deadenz.CraftCommandType, profile)
+
+			var limitErr *middleware.RateLimitError
+
+			require.ErrorIs(t, err, middleware.ErrRateLimited)
+			require.True(t, errors.As(err, &limitErr))
+
+			assert.Equal(t, deadenz.CraftCommandType, limitErr.Command)
+			assert.InDelta(t, 6*time.Minute, limitErr.RetryAfter, float64(time.Second))
+		})
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("other commands are not limited", func(t *testing.T) {
+			t.Parallel()
+
+			profile := &components.Profile{}
+			newProfile, err := limiter(context.Background(), deadenz.WalkCommandType, profile)
+
+			require.NoError(t, err)
+			assert.Nil(t, newProfile.Limits)
+		})
+
+		t.Run("first use initializes command state", func(t *testing.T) {
+			t.Parallel()
+
+			newProfile, err := limiter(context.Background(), deadenz.CraftCommandType, &components.Profile{})
+
+			require.NoError(t, err)
+			require.NotNil(t, newProfile.Limits)
+			assert.Equal(t, uint64(1), newProfile.Limits.Commands["craft"].Count)
+		})
+
+		t.Run("regeneration keeps partial progress", func(t *testing.T) {
+			t.Parallel()
+
+			last := time.Now().Add(-25 * time.Minute)
+			profile := &components.Profile{
+				Limits: &components.Limits{
+					Commands: map[string]components.CommandLimit{
+						"craft": {Last: last, Count: 3},
+					},
+				},
+			}
+			newProfile, err := limiter(context.Background(), deadenz.CraftCommandType, profile)
+
+			require.NoError(t, err)
+
+			state := newProfile.Limits.Commands["craft"]
+
+			assert.Equal(t, uint64(2), state.Count)
+			assert.Equal(t, last.Add(20*time.Minute), state.Last)
+		})
+	})
+}
+
+func TestCommandLimiter_Burst(t *testing.T) {
+	t.Parallel()
+
+	limiter := middleware.CommandLimiter(deadenz.WalkCommandType, middleware.RateLimit{
+		Capacity:     10,
+		Regeneration: time.Hour,
+		Burst:        3,
+	})
+
+	result, err := deadenz.RunWithMiddleware(
+		context.Background(),
+		deadenz.WalkCommandType,
+		&components.Profile{Active: &components.Character{}},
+		nil,
+		[]deadenz.Middleware{deadenz.PreRunMiddleware(limiter), stubCommand},
+		deadenz.WithRepeat(5),
+	)
+
+	require.NoError(t, err)
+	require.ErrorIs(t, result.Stopped, middleware.ErrBurstExceeded)
+
+	assert.Equal(t, uint(3), result.Steps)
+	assert.Equal(t, uint64(3), result.Profile.Limits.Commands["walk"].Count)
+}
+
+func TestCommandLimitsFromConfig(t *testing.T) {
+	t.Parallel()
+
+	var chain middleware.ChainConfig
+
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"pre_run": [
+			{"name": "walk_limiter", "params": {"hourly_limit": 12}},
+			{"name": "command_limiter", "params": {"command": "craft", "capacity": 6, "regeneration": "10m", "burst": 2}}
+		]
+	}`), &chain))
+
+	assert.Equal(t, []middleware.CommandRateLimit{{
+		Command: deadenz.CraftCommandType,
+		Limit: middleware.RateLimit{
+			Capacity:     6,
+			Regeneration: 10 * time.Minute,
+			Burst:        2,
+		},
+	}}, middleware.CommandLimitsFromConfig(chain))
+}
+
+// stubCommand completes a command without running it so that limiters can be tested through repeated requests.
+func stubCommand(_ deadenz.Handler) deadenz.Handler {
+	return func(_ context.Context, _ deadenz.CommandType, profile *components.Profile) (deadenz.Result, error) {
+		return deadenz.Result{Profile: profile, Steps: 1}, nil
+	}
+}
@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 	"encoding/json"
+	"log"
 
 	deadenz "github.com/ciphermountain/deadenz/pkg"
 	"github.com/ciphermountain/deadenz/pkg/components"
@@ -10,39 +11,132 @@ import (
 	service "github.com/ciphermountain/deadenz/pkg/service/multiverse"
 )
 
+func init() {
+	RegisterPostRunCtx("publish_multiverse", newPublishEventsToMultiverse)
+	RegisterPostRunCtx("multiverse_registration", newMultiverseRegistration)
+}
+
+// EventPublisher publishes game events to the multiverse, either directly or through a background publisher.
+type EventPublisher interface {
+	PublishGameEvent(ctx context.Context, uid string, data []byte) error
+}
+
+// Registrar records which character each player is playing in the multiverse.
+type Registrar interface {
+	Register(ctx context.Context, uid string, character components.CharacterType) error
+	Deregister(ctx context.Context, uid string) error
+}
+
+// MultiverseRegistration registers a player with the multiverse when their character spawns and deregisters them
+// when it dies. A registration error fails the command.
+func MultiverseRegistration(registrar Registrar) deadenz.PostRunCtxFunc {
+	return func(ctx context.Context, _ deadenz.CommandType, profile *components.Profile, evts []components.Event) (*components.Profile, error) {
+		if registrar == nil {
+			return profile, nil
+		}
+
+		for _, evt := range evts {
+			switch typed := evt.(type) {
+			case *events.CharacterSpawnEvent:
+				if err := registrar.Register(ctx, profile.UUID, typed.Type()); err != nil {
+					return profile, err
+				}
+			case events.CharacterSpawnEvent:
+				if err := registrar.Register(ctx, profile.UUID, typed.Type()); err != nil {
+					return profile, err
+				}
+			case events.DieMutationEvent:
+				if err := registrar.Deregister(ctx, profile.UUID); err != nil {
+					return profile, err
+				}
+			}
+		}
+
+		return profile, nil
+	}
+}
+
+// PublishEventsToMultiverse publishes spawn, death, craft, rare find, and progress events to the multiverse without
+// a deadline.
 func PublishEventsToMultiverse(client *service.Client) deadenz.PostRunFunc {
+	publish := PublishEventsToMultiverseCtx(client)
+
 	return func(cmd deadenz.CommandType, profile *components.Profile, evts []components.Event) (*components.Profile, error) {
-		// passthrough if not walk or spawnin command
-		if cmd != deadenz.WalkCommandType && cmd != deadenz.SpawninCommandType {
+		return publish(context.Background(), cmd, profile, evts)
+	}
+}
+
+// PublishEventsToMultiverseCtx publishes spawn, death, craft, rare find, and progress events to the multiverse using
+// the command context.
+func PublishEventsToMultiverseCtx(client *service.Client) deadenz.PostRunCtxFunc {
+	if client == nil {
+		return PublishEvents(nil)
+	}
+
+	return PublishEvents(client)
+}
+
+// PublishEvents publishes spawn, death, craft, rare find, and progress events with the publisher. Events that
+// cannot be published are reported without failing the command.
+func PublishEvents(publisher EventPublisher) deadenz.PostRunCtxFunc {
+	return func(ctx context.Context, cmd deadenz.CommandType, profile *components.Profile, evts []components.Event) (*components.Profile, error) {
+		// passthrough if not walk, spawnin, or craft command
+		if cmd != deadenz.WalkCommandType && cmd != deadenz.SpawninCommandType && cmd != deadenz.CraftCommandType {
 			return profile, nil
 		}
 
-		if client != nil {
-			publishEvents(profile, evts, client)
+		if publisher != nil {
+			publishEvents(ctx, profile, evts, publisher)
 		}
 
 		return profile, nil
 	}
 }
 
-func publishEvents(profile *components.Profile, evts []components.Event, client *service.Client) {
+func publishEvents(ctx context.Context, profile *components.Profile, evts []components.Event, publisher EventPublisher) {
 	for _, evt := range evts {
+		var err error
+
 		switch typed := evt.(type) {
 		case events.DieMutationEvent:
-			_ = marshalAndSend(events.NewDieMutationEventWithCharacter(*profile.Active, typed), client, profile.UUID)
-		case events.CharacterSpawnEvent: // only spawn and die events are supported
-			_ = marshalAndSend(typed, client, profile.UUID)
+			err = marshalAndSend(ctx, events.NewDieMutationEventWithCharacter(*profile.Active, typed), publisher, profile.UUID)
+		case *events.CharacterSpawnEvent: // only spawn, die, craft, rare find, and progress events are supported
+			err = marshalAndSend(ctx, typed, publisher, profile.UUID)
+		case events.CharacterSpawnEvent:
+			err = marshalAndSend(ctx, typed, publisher, profile.UUID)
+		case events.CraftEvent, events.LevelUpEvent, events.XPMilestoneEvent:
+			err = marshalAndSend(ctx, typed, publisher, profile.UUID)
+		case events.FindEvent:
+			if typed.Item.Rare {
+				err = marshalAndSend(ctx, typed, publisher, profile.UUID)
+			}
 		default:
 			continue
 		}
+
+		if err != nil {
+			log.Printf("failed to publish multiverse event for %s: %s", profile.UUID, err.Error())
+		}
 	}
 }
 
-func marshalAndSend[T any](evt T, client *service.Client, id string) error {
+func marshalAndSend[T any](ctx context.Context, evt T, publisher EventPublisher, id string) error {
 	bts, err := json.Marshal(evt)
 	if err != nil {
 		return err
 	}
 
-	return client.PublishGameEvent(context.Background(), id, bts)
+	return publisher.PublishGameEvent(ctx, id, bts)
+}
+
+func newPublishEventsToMultiverse(_ json.RawMessage, deps Dependencies) (deadenz.PostRunCtxFunc, error) {
+	if deps.Publisher != nil {
+		return PublishEvents(deps.Publisher), nil
+	}
+
+	return PublishEventsToMultiverseCtx(deps.Multiverse), nil
+}
+
+func newMultiverseRegistration(_ json.RawMessage, deps Dependencies) (deadenz.PostRunCtxFunc, error) {
+	return MultiverseRegistration(deps.Registrar), nil
 }
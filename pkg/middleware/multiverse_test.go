@@ -0,0 +1,85 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	deadenz "github.com/ciphermountain/deadenz/pkg"
+	"github.com/ciphermountain/deadenz/pkg/components"
+	"github.com/ciphermountain/deadenz/pkg/events"
+	"github.com/ciphermountain/deadenz/pkg/middleware"
+)
+
+func TestMultiverseRegistration(t *testing.T) {
+	t.Parallel()
+
+	t.Run("spawn events register the character", func(t *testing.T) {
+		t.Parallel()
+
+		registrar := &fakeRegistrar{}
+		profile := &components.Profile{UUID: "player"}
+		evts := []components.Event{events.NewCharacterSpawnEvent(components.Character{Type: 3})}
+
+		_, err := middleware.MultiverseRegistration(registrar)(context.Background(), deadenz.SpawninCommandType, profile, evts)
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]components.CharacterType{"player": 3}, registrar.registered)
+	})
+
+	t.Run("death events deregister the player", func(t *testing.T) {
+		t.Parallel()
+
+		registrar := &fakeRegistrar{registered: map[string]components.CharacterType{"player": 3}}
+		profile := &components.Profile{UUID: "player", Active: &components.Character{Type: 3}}
+		evts := []components.Event{events.NewDieMutationEvent("you died")}
+
+		_, err := middleware.MultiverseRegistration(registrar)(context.Background(), deadenz.WalkCommandType, profile, evts)
+
+		require.NoError(t, err)
+		assert.Empty(t, registrar.registered)
+	})
+
+	t.Run("registration errors fail the command", func(t *testing.T) {
+		t.Parallel()
+
+		registrar := &fakeRegistrar{err: errors.New("closed")}
+		evts := []components.Event{events.NewCharacterSpawnEvent(components.Character{Type: 3})}
+
+		_, err := middleware.MultiverseRegistration(registrar)(context.Background(), deadenz.SpawninCommandType, &components.Profile{}, evts)
+
+		require.Error(t, err)
+	})
+}
+
+type fakeRegistrar struct {
+	registered map[string]components.CharacterType
+	err        error
+}
+
+func (r *fakeRegistrar) Register(_ context.Context, uid string, character components.CharacterType) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if r.registered == nil {
+		r.registered = make(map[string]components.CharacterType)
+	}
+
+	r.registered[uid] = character
+
+	return nil
+}
+
+func (r *fakeRegistrar) Deregister(_ context.Context, uid string) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	delete(r.registered, uid)
+
+	return nil
+}
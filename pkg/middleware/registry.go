@@ -0,0 +1,260 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	deadenz "github.com/ciphermountain/deadenz/pkg"
+	service "github.com/ciphermountain/deadenz/pkg/service/multiverse"
+)
+
+// Dependencies are the shared services made available to registered middleware constructors.
+type Dependencies struct {
+	Items      ItemProvider
+	Multiverse *service.Client
+	// Registrar is nil when the multiverse is not available.
+	Registrar Registrar
+	// Publisher publishes events in the background. Events are published directly with Multiverse when nil.
+	Publisher EventPublisher
+}
+
+// PreRunConstructor builds a pre-run middleware from configured parameters. Params may be empty.
+type PreRunConstructor func(params json.RawMessage, deps Dependencies) (deadenz.PreRunFunc, error)
+
+// PostRunConstructor builds a post-run middleware from configured parameters. Params may be empty.
+type PostRunConstructor func(params json.RawMessage, deps Dependencies) (deadenz.PostRunFunc, error)
+
+// PreRunCtxConstructor builds a context aware pre-run middleware from configured parameters.
+type PreRunCtxConstructor func(params json.RawMessage, deps Dependencies) (deadenz.PreRunCtxFunc, error)
+
+// PostRunCtxConstructor builds a context aware post-run middleware from configured parameters.
+type PostRunCtxConstructor func(params json.RawMessage, deps Dependencies) (deadenz.PostRunCtxFunc, error)
+
+// AroundConstructor builds a wrapping middleware from configured parameters. Params may be empty.
+type AroundConstructor func(params json.RawMessage, deps Dependencies) (deadenz.Middleware, error)
+
+// Config references a registered middleware by name along with the parameters to construct it with.
+type Config struct {
+	Name   string          `json:"name"`
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+// ChainConfig declares the middleware chains in the order they are applied. Around middleware wraps both the
+// pre-run and post-run chains with the first being the outermost.
+type ChainConfig struct {
+	Around  []Config `json:"around,omitempty"`
+	PreRun  []Config `json:"pre_run"`
+	PostRun []Config `json:"post_run"`
+}
+
+// DefaultChainConfig returns the middleware chains used when no configuration is provided.
+func DefaultChainConfig() ChainConfig {
+	return ChainConfig{
+		Around: []Config{
+			{Name: "profile_lock"},
+		},
+		PreRun: []Config{
+			{Name: "walk_limiter", Params: json.RawMessage(`{"hourly_limit": 12, "burst": 6}`)},
+			{Name: "walk_stat_builder", Params: json.RawMessage(`{"commands": ["walk"]}`)},
+			{Name: "command_limiter", Params: json.RawMessage(`{"command": "craft", "capacity": 6, "regeneration": "10m"}`)},
+			{Name: "command_limiter", Params: json.RawMessage(`{"command": "rest", "capacity": 2, "regeneration": "10m"}`)},
+		},
+		PostRun: []Config{
+			{Name: "multiverse_registration"},
+			{Name: "publish_multiverse"},
+			{Name: "death_active_item"},
+			{Name: "walk_death"},
+		},
+	}
+}
+
+var (
+	registryMu sync.RWMutex
+	preRuns    = make(map[string]PreRunCtxConstructor)
+	postRuns   = make(map[string]PostRunCtxConstructor)
+	arounds    = make(map[string]AroundConstructor)
+)
+
+// RegisterPreRun makes a pre-run middleware constructor available by name. It panics if the name is already
+// registered or the constructor is nil.
+func RegisterPreRun(name string, constructor PreRunConstructor) {
+	if constructor == nil {
+		panic("middleware: pre-run constructor is nil for " + name)
+	}
+
+	RegisterPreRunCtx(name, func(params json.RawMessage, deps Dependencies) (deadenz.PreRunCtxFunc, error) {
+		f, err := constructor(params, deps)
+		if err != nil {
+			return nil, err
+		}
+
+		return deadenz.PreRunWithContext(f), nil
+	})
+}
+
+// RegisterPreRunCtx makes a context aware pre-run middleware constructor available by name. It panics if the
+// name is already registered or the constructor is nil.
+func RegisterPreRunCtx(name string, constructor PreRunCtxConstructor) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if constructor == nil {
+		panic("middleware: pre-run constructor is nil for " + name)
+	}
+
+	if _, exists := preRuns[name]; exists {
+		panic("middleware: pre-run registered twice for " + name)
+	}
+
+	preRuns[name] = constructor
+}
+
+// RegisterPostRun makes a post-run middleware constructor available by name. It panics if the name is already
+// registered or the constructor is nil.
+func RegisterPostRun(name string, constructor PostRunConstructor) {
+	if constructor == nil {
+		panic("middleware: post-run constructor is nil for " + name)
+	}
+
+	RegisterPostRunCtx(name, func(params json.RawMessage, deps Dependencies) (deadenz.PostRunCtxFunc, error) {
+		f, err := constructor(params, deps)
+		if err != nil {
+			return nil, err
+		}
+
+		return deadenz.PostRunWithContext(f), nil
+	})
+}
+
+// RegisterPostRunCtx makes a context aware post-run middleware constructor available by name. It panics if the
+// name is already registered or the constructor is nil.
+func RegisterPostRunCtx(name string, constructor PostRunCtxConstructor) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if constructor == nil {
+		panic("middleware: post-run constructor is nil for " + name)
+	}
+
+	if _, exists := postRuns[name]; exists {
+		panic("middleware: post-run registered twice for " + name)
+	}
+
+	postRuns[name] = constructor
+}
+
+// RegisterAround makes a wrapping middleware constructor available by name. It panics if the name is already
+// registered or the constructor is nil.
+func RegisterAround(name string, constructor AroundConstructor) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if constructor == nil {
+		panic("middleware: around constructor is nil for " + name)
+	}
+
+	if _, exists := arounds[name]; exists {
+		panic("middleware: around registered twice for " + name)
+	}
+
+	arounds[name] = constructor
+}
+
+// Build constructs the full configured chain as wrapping middleware with around middleware outermost followed by
+// the pre-run and post-run chains.
+func Build(chain ChainConfig, deps Dependencies) ([]deadenz.Middleware, error) {
+	around, err := BuildAround(chain.Around, deps)
+	if err != nil {
+		return nil, err
+	}
+
+	preRun, err := BuildPreRun(chain.PreRun, deps)
+	if err != nil {
+		return nil, err
+	}
+
+	postRun, err := BuildPostRun(chain.PostRun, deps)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(around, deadenz.MiddlewareFromRunFuncs(preRun, postRun)...), nil
+}
+
+// BuildAround constructs the configured wrapping middleware in order.
+func BuildAround(configs []Config, deps Dependencies) ([]deadenz.Middleware, error) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	chain := make([]deadenz.Middleware, len(configs))
+
+	for idx, conf := range configs {
+		constructor, ok := arounds[conf.Name]
+		if !ok {
+			return nil, fmt.Errorf("unknown around middleware: %s", conf.Name)
+		}
+
+		var err error
+
+		if chain[idx], err = constructor(conf.Params, deps); err != nil {
+			return nil, fmt.Errorf("around middleware %s: %w", conf.Name, err)
+		}
+	}
+
+	return chain, nil
+}
+
+// BuildPreRun constructs the configured pre-run chain in order.
+func BuildPreRun(configs []Config, deps Dependencies) ([]deadenz.PreRunCtxFunc, error) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	chain := make([]deadenz.PreRunCtxFunc, len(configs))
+
+	for idx, conf := range configs {
+		constructor, ok := preRuns[conf.Name]
+		if !ok {
+			return nil, fmt.Errorf("unknown pre-run middleware: %s", conf.Name)
+		}
+
+		var err error
+
+		if chain[idx], err = constructor(conf.Params, deps); err != nil {
+			return nil, fmt.Errorf("pre-run middleware %s: %w", conf.Name, err)
+		}
+	}
+
+	return chain, nil
+}
+
+// BuildPostRun constructs the configured post-run chain in order.
+func BuildPostRun(configs []Config, deps Dependencies) ([]deadenz.PostRunCtxFunc, error) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	chain := make([]deadenz.PostRunCtxFunc, len(configs))
+
+	for idx, conf := range configs {
+		constructor, ok := postRuns[conf.Name]
+		if !ok {
+			return nil, fmt.Errorf("unknown post-run middleware: %s", conf.Name)
+		}
+
+		var err error
+
+		if chain[idx], err = constructor(conf.Params, deps); err != nil {
+			return nil, fmt.Errorf("post-run middleware %s: %w", conf.Name, err)
+		}
+	}
+
+	return chain, nil
+}
+
+func decodeParams(params json.RawMessage, value any) error {
+	if len(params) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(params, value)
+}
@@ -0,0 +1,81 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	deadenz "github.com/ciphermountain/deadenz/pkg"
+	"github.com/ciphermountain/deadenz/pkg/components"
+	"github.com/ciphermountain/deadenz/pkg/middleware"
+	"github.com/ciphermountain/deadenz/pkg/middleware/mocks"
+)
+
+func TestBuildChains(t *testing.T) {
+	t.Parallel()
+
+	deps := middleware.Dependencies{Items: mocks.NewMockItemProvider(t)}
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("unregistered name should return error", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := middleware.BuildPreRun([]middleware.Config{{Name: "does_not_exist"}}, deps)
+
+			require.Error(t, err)
+		})
+
+		t.Run("invalid params should return error", func(t *testing.T) {
+			t.Parallel()
+
+			conf := []middleware.Config{{Name: "walk_stat_builder", Params: json.RawMessage(`{"commands": ["fly"]}`)}}
+			_, err := middleware.BuildPreRun(conf, deps)
+
+			require.ErrorIs(t, err, deadenz.ErrUnrecognizedCommand)
+		})
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("default config builds chains in order", func(t *testing.T) {
+			t.Parallel()
+
+			conf := middleware.DefaultChainConfig()
+
+			pre, err := middleware.BuildPreRun(conf.PreRun, deps)
+			require.NoError(t, err)
+
+			post, err := middleware.BuildPostRun(conf.PostRun, deps)
+			require.NoError(t, err)
+
+			around, err := middleware.BuildAround(conf.Around, deps)
+			require.NoError(t, err)
+
+			assert.Len(t, pre, len(conf.PreRun))
+			assert.Len(t, post, len(conf.PostRun))
+			assert.Len(t, around, len(conf.Around))
+		})
+
+		t.Run("registered constructors are available by name", func(t *testing.T) {
+			t.Parallel()
+
+			middleware.RegisterPreRun("test_noop", func(_ json.RawMessage, _ middleware.Dependencies) (deadenz.PreRunFunc, error) {
+				return func(_ deadenz.CommandType, profile *components.Profile) (*components.Profile, error) {
+					return profile, nil
+				}, nil
+			})
+
+			t.Cleanup(func() { middleware.UnregisterPreRun("test_noop") })
+
+			pre, err := middleware.BuildPreRun([]middleware.Config{{Name: "test_noop"}}, deps)
+
+			require.NoError(t, err)
+			require.Len(t, pre, 1)
+		})
+	})
+}
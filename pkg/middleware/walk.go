@@ -1,9 +1,9 @@
 package middleware
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
-	"fmt"
-	"math"
 	"time"
 
 	deadenz "github.com/ciphermountain/deadenz/pkg"
@@ -21,66 +21,113 @@ type ItemProvider interface {
 	Items() ([]components.Item, error)
 }
 
-// WalkLimiter applies defined limits to the walk command usage on a profile
-func WalkLimiter(hourlyLimit uint16, items ItemProvider) deadenz.PreRunFunc {
-	return func(cmd deadenz.CommandType, profile *components.Profile) (*components.Profile, error) {
-		if cmd != deadenz.WalkCommandType {
+func init() {
+	RegisterPreRunCtx("walk_limiter", newWalkLimiter)
+	RegisterPreRun("walk_stat_builder", newWalkStatBuilder)
+	RegisterPostRun("walk_death", newWalkDeathEventMiddleware)
+}
+
+// WalkLimiter applies defined limits to the walk command usage on a profile. A profile may walk once more than the
+// hourly limit and recovers a walk every hour divided by the limit. Burst is the most walks a single request may
+// take and zero allows any number. Walks recovered over time are applied before a rest so that a profile is not
+// charged for walks it has already recovered.
+func WalkLimiter(hourlyLimit uint16, burst uint64, items ItemProvider) deadenz.PreRunCtxFunc {
+	limiter := walkLimiter(hourlyLimit, burst, items)
+
+	return func(ctx context.Context, cmd deadenz.CommandType, profile *components.Profile) (*components.Profile, error) {
+		if cmd == deadenz.RestCommandType && profile != nil {
+			limiter.regenerate(profile, time.Now())
+
 			return profile, nil
 		}
 
-		if profile == nil {
-			return nil, ErrNilProfile
-		}
+		return limiter.preRun(ctx, cmd, profile)
+	}
+}
 
-		limit := int64(hourlyLimit)
+// WalkStatus is the walking capacity of a profile at a point in time.
+type WalkStatus struct {
+	// Limit is the hourly walk limit including any improvement from the active item.
+	Limit uint64
+	// Remaining is the number of walks allowed before the profile must wait or rest.
+	Remaining uint64
+	// NextWalk is the earliest time the profile is allowed to walk.
+	NextWalk time.Time
+}
 
-		// if active item can extend limit, use it
-		if profile.ActiveItem != nil {
-			if item, err := items.Item(*profile.ActiveItem); err == nil {
-				if item.IsUsable() {
-					usable := item.AsUsableItem()
+// WalkCapacity computes the walking capacity of a profile using the same rules as WalkLimiter.
+func WalkCapacity(hourlyLimit uint16, items ItemProvider, profile *components.Profile, now time.Time) WalkStatus {
+	status := walkLimiter(hourlyLimit, 0, items).capacity(profile, now)
 
-					if usable.ImprovesWalking() {
-						limit = int64(1+usable.Efficiency(profile.Stats)) * limit
-					}
-				}
-			}
-		}
+	return WalkStatus{
+		Limit:     uint64(walkLimit(hourlyLimit, items, profile)),
+		Remaining: status.Remaining,
+		NextWalk:  status.NextUse,
+	}
+}
 
-		if profile.Limits == nil {
-			profile.Limits = &components.Limits{
-				LastWalk:  time.Now(),
-				WalkCount: 1,
-			}
+// walkLimiter is the command limiter for walking. Walk usage is kept in the walk fields of the profile limits.
+func walkLimiter(hourlyLimit uint16, burst uint64, items ItemProvider) limiter {
+	return limiter{
+		command: deadenz.WalkCommandType,
+		limit: func(profile *components.Profile) RateLimit {
+			limit := walkLimit(hourlyLimit, items, profile)
+			rate := RateLimit{Capacity: uint64(limit) + 1, Burst: burst}
 
-			return profile, nil
-		}
+			if limit > 0 {
+				rate.Regeneration = time.Hour / time.Duration(limit)
+			}
 
-		diff := time.Since(profile.Limits.LastWalk) / time.Millisecond // duration since last walk
-		unit := float64(time.Hour/time.Millisecond) / float64(limit)
-		jumps := math.Floor(float64(diff) / unit)
+			return rate
+		},
+		err: ErrWalkTooMuch,
+		load: func(limits *components.Limits) components.CommandLimit {
+			return components.CommandLimit{Last: limits.LastWalk, Count: limits.WalkCount}
+		},
+		store: func(limits *components.Limits, state components.CommandLimit) {
+			limits.LastWalk = state.Last
+			limits.WalkCount = state.Count
+		},
+	}
+}
 
-		count := profile.Limits.WalkCount - uint64(jumps)
+// walkLimit is the hourly limit extended by the efficiency of an active item that improves walking.
+func walkLimit(hourlyLimit uint16, items ItemProvider, profile *components.Profile) int64 {
+	limit := int64(hourlyLimit)
 
-		// check overflow
-		if count > profile.Limits.WalkCount {
-			count = 0
+	// if active item can extend limit, use it
+	if profile.ActiveItem != nil {
+		if item, err := items.Item(*profile.ActiveItem); err == nil {
+			if improvesWalking(item) {
+				limit = int64(1+item.AsUsableItem().Efficiency(profile.Stats)) * limit
+			}
 		}
+	}
 
-		profile.Limits.WalkCount = count
+	return limit
+}
 
-		if profile.Limits.WalkCount > uint64(limit) {
-			return profile, fmt.Errorf("%w", ErrWalkTooMuch)
+// WalkLimitFromConfig returns the hourly limit of the first configured walk limiter.
+func WalkLimitFromConfig(chain ChainConfig) (uint16, bool) {
+	for _, conf := range chain.PreRun {
+		if conf.Name != "walk_limiter" {
+			continue
 		}
 
-		profile.Limits.LastWalk = time.Now()
-		profile.Limits.WalkCount++
+		params, err := walkLimiterParams(conf.Params)
+		if err != nil {
+			return 0, false
+		}
 
-		return profile, nil
+		return params.HourlyLimit, true
 	}
+
+	return 0, false
 }
 
-func WalkStatBuilder(it components.ItemType, items ItemProvider, cmds ...deadenz.CommandType) deadenz.PreRunFunc {
+// WalkStatBuilder applies the mutators of the active item for the provided commands as long as the active item
+// definition improves walking.
+func WalkStatBuilder(items ItemProvider, cmds ...deadenz.CommandType) deadenz.PreRunFunc {
 	return func(cmd deadenz.CommandType, profile *components.Profile) (*components.Profile, error) {
 		var found bool
 
@@ -94,11 +141,11 @@ func WalkStatBuilder(it components.ItemType, items ItemProvider, cmds ...deadenz
 			return profile, nil
 		}
 
-		if profile.ActiveItem == nil || *profile.ActiveItem != it {
+		if profile.ActiveItem == nil {
 			return profile, nil
 		}
 
-		if item, err := items.Item(it); err == nil {
+		if item, err := items.Item(*profile.ActiveItem); err == nil && improvesWalking(item) {
 			profile = item.Mutate(profile)
 		}
 
@@ -106,6 +153,10 @@ func WalkStatBuilder(it components.ItemType, items ItemProvider, cmds ...deadenz
 	}
 }
 
+func improvesWalking(item *components.Item) bool {
+	return item.IsUsable() && item.AsUsableItem().ImprovesWalking()
+}
+
 func WalkDeathEventMiddleware() deadenz.PostRunFunc {
 	return func(_ deadenz.CommandType, profile *components.Profile, evts []components.Event) (*components.Profile, error) {
 		if profile.Active == nil {
@@ -125,3 +176,54 @@ func WalkDeathEventMiddleware() deadenz.PostRunFunc {
 		return profile, nil
 	}
 }
+
+type walkLimiterConfig struct {
+	HourlyLimit uint16 `json:"hourly_limit"`
+	Burst       uint64 `json:"burst"`
+}
+
+func walkLimiterParams(params json.RawMessage) (walkLimiterConfig, error) {
+	conf := walkLimiterConfig{
+		HourlyLimit: 12,
+	}
+
+	if err := decodeParams(params, &conf); err != nil {
+		return walkLimiterConfig{}, err
+	}
+
+	return conf, nil
+}
+
+func newWalkLimiter(params json.RawMessage, deps Dependencies) (deadenz.PreRunCtxFunc, error) {
+	conf, err := walkLimiterParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return WalkLimiter(conf.HourlyLimit, conf.Burst, deps.Items), nil
+}
+
+func newWalkStatBuilder(params json.RawMessage, deps Dependencies) (deadenz.PreRunFunc, error) {
+	var conf struct {
+		Commands []string `json:"commands"`
+	}
+
+	if err := decodeParams(params, &conf); err != nil {
+		return nil, err
+	}
+
+	cmds := make([]deadenz.CommandType, len(conf.Commands))
+	for idx, name := range conf.Commands {
+		var err error
+
+		if cmds[idx], err = deadenz.ParseCommandType(name); err != nil {
+			return nil, err
+		}
+	}
+
+	return WalkStatBuilder(deps.Items, cmds...), nil
+}
+
+func newWalkDeathEventMiddleware(_ json.RawMessage, _ Dependencies) (deadenz.PostRunFunc, error) {
+	return WalkDeathEventMiddleware(), nil
+}
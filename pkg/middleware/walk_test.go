@@ -1,6 +1,7 @@
 package middleware_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -20,7 +21,7 @@ func TestWalkLimiter(t *testing.T) {
 	t.Parallel()
 
 	mockItemProvider := mocks.NewMockItemProvider(t)
-	limiter := middleware.WalkLimiter(2, mockItemProvider)
+	limiter := middleware.WalkLimiter(2, 0, mockItemProvider)
 
 	t.Run("Failure", func(t *testing.T) {
 		t.Parallel()
@@ -29,7 +30,7 @@ func TestWalkLimiter(t *testing.T) {
 			t.Parallel()
 
 			profile := &components.Profile{}
-			newProfile, err := limiter(deadenz.SpawninCommandType, profile)
+			newProfile, err := limiter(context.Background(), deadenz.SpawninCommandType, profile)
 
 			require.NoError(t, err)
 			assert.Equal(t, profile, newProfile)
@@ -38,7 +39,7 @@ func TestWalkLimiter(t *testing.T) {
 		t.Run("nil profile should return error", func(t *testing.T) {
 			t.Parallel()
 
-			profile, err := limiter(deadenz.WalkCommandType, nil)
+			profile, err := limiter(context.Background(), deadenz.WalkCommandType, nil)
 
 			require.ErrorIs(t, err, middleware.ErrNilProfile)
 			assert.Nil(t, profile)
@@ -53,7 +54,7 @@ func TestWalkLimiter(t *testing.T) {
 					WalkCount: 3,
 				},
 			}
-			newProfile, err := limiter(deadenz.WalkCommandType, profile)
+			newProfile, err := limiter(context.Background(), deadenz.WalkCommandType, profile)
 
 			require.ErrorIs(t, err, middleware.ErrWalkTooMuch)
 			assert.Equal(t, profile, newProfile)
@@ -68,7 +69,7 @@ func TestWalkLimiter(t *testing.T) {
 
 			start := time.Now()
 			profile := &components.Profile{}
-			newProfile, err := limiter(deadenz.WalkCommandType, profile)
+			newProfile, err := limiter(context.Background(), deadenz.WalkCommandType, profile)
 
 			require.NoError(t, err)
 			require.NotNil(t, newProfile.Limits)
@@ -77,23 +78,23 @@ func TestWalkLimiter(t *testing.T) {
 			assert.GreaterOrEqual(t, newProfile.Limits.LastWalk, start)
 		})
 
-		t.Run("valid walk increases walk count by 1", func(t *testing.T) {
+		t.Run("valid walk increases walk count by 1 and keeps progress toward the next recovered walk", func(t *testing.T) {
 			t.Parallel()
 
-			start := time.Now()
+			lastWalk := time.Now().Add(-1 * time.Minute)
 			profile := &components.Profile{
 				Limits: &components.Limits{
-					LastWalk:  time.Now().Add(-1 * time.Minute),
+					LastWalk:  lastWalk,
 					WalkCount: 1,
 				},
 			}
-			newProfile, err := limiter(deadenz.WalkCommandType, profile)
+			newProfile, err := limiter(context.Background(), deadenz.WalkCommandType, profile)
 
 			require.NoError(t, err)
 			require.NotNil(t, newProfile.Limits)
 
 			assert.Equal(t, uint64(2), newProfile.Limits.WalkCount)
-			assert.GreaterOrEqual(t, newProfile.Limits.LastWalk.UnixMilli(), start.UnixMilli())
+			assert.Equal(t, lastWalk, newProfile.Limits.LastWalk)
 		})
 
 		t.Run("active item increases walking limit", func(t *testing.T) {
@@ -113,24 +114,24 @@ func TestWalkLimiter(t *testing.T) {
 
 			mockItemProvider.EXPECT().Item(mock.Anything).Return(&item, nil)
 
-			start := time.Now()
+			lastWalk := time.Now().Add(-1 * time.Minute)
 			profile := &components.Profile{
 				ActiveItem: &itemID,
 				Limits: &components.Limits{
-					LastWalk:  time.Now().Add(-1 * time.Minute),
+					LastWalk:  lastWalk,
 					WalkCount: 4,
 				},
 				Stats: components.Stats{
 					Skill: 10,
 				},
 			}
-			newProfile, err := limiter(deadenz.WalkCommandType, profile)
+			newProfile, err := limiter(context.Background(), deadenz.WalkCommandType, profile)
 
 			require.NoError(t, err)
 			require.NotNil(t, newProfile.Limits)
 
 			assert.Equal(t, uint64(5), newProfile.Limits.WalkCount)
-			assert.GreaterOrEqual(t, newProfile.Limits.LastWalk.UnixMilli(), start.UnixMilli())
+			assert.Equal(t, lastWalk, newProfile.Limits.LastWalk)
 		})
 
 		t.Run("recent walk after long wait upcounts walk limit", func(t *testing.T) {
@@ -143,7 +144,7 @@ func TestWalkLimiter(t *testing.T) {
 					WalkCount: 3,
 				},
 			}
-			newProfile, err := limiter(deadenz.WalkCommandType, profile)
+			newProfile, err := limiter(context.Background(), deadenz.WalkCommandType, profile)
 
 			require.NoError(t, err)
 			require.NotNil(t, newProfile.Limits)
@@ -151,5 +152,119 @@ func TestWalkLimiter(t *testing.T) {
 			assert.Equal(t, uint64(2), newProfile.Limits.WalkCount)
 			assert.GreaterOrEqual(t, newProfile.Limits.LastWalk.UnixMilli(), start.UnixMilli())
 		})
+
+		t.Run("rest applies walks recovered over time and keeps partial progress", func(t *testing.T) {
+			t.Parallel()
+
+			// two walks an hour recover a walk every 30 minutes
+			lastWalk := time.Now().Add(-40 * time.Minute)
+			profile := &components.Profile{
+				Currency: deadenz.RestCurrencyCost,
+				Limits: &components.Limits{
+					LastWalk:  lastWalk,
+					WalkCount: 5,
+				},
+			}
+			newProfile, err := limiter(context.Background(), deadenz.RestCommandType, profile)
+
+			require.NoError(t, err)
+			assert.Equal(t, uint64(4), newProfile.Limits.WalkCount)
+			assert.Equal(t, lastWalk.Add(30*time.Minute), newProfile.Limits.LastWalk)
+
+			rested, _, err := deadenz.Rest(newProfile, deadenz.RestWithCurrency, 0, nil)
+
+			require.NoError(t, err)
+			assert.Equal(t, uint64(1), rested.Limits.WalkCount)
+		})
+
+		t.Run("fully recovered profile is well rested and pays nothing", func(t *testing.T) {
+			t.Parallel()
+
+			profile := &components.Profile{
+				Currency: deadenz.RestCurrencyCost,
+				Limits: &components.Limits{
+					LastWalk:  time.Now().Add(-2 * time.Hour),
+					WalkCount: 3,
+				},
+			}
+			newProfile, err := limiter(context.Background(), deadenz.RestCommandType, profile)
+
+			require.NoError(t, err)
+			assert.Equal(t, uint64(0), newProfile.Limits.WalkCount)
+
+			rested, _, err := deadenz.Rest(newProfile, deadenz.RestWithCurrency, 0, nil)
+
+			require.ErrorIs(t, err, deadenz.ErrWellRested)
+			assert.Equal(t, uint(deadenz.RestCurrencyCost), rested.Currency)
+		})
 	})
 }
+
+func TestWalkCapacity(t *testing.T) {
+	t.Parallel()
+
+	mockItemProvider := mocks.NewMockItemProvider(t)
+
+	t.Run("profile without limits has full capacity", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		status := middleware.WalkCapacity(2, mockItemProvider, &components.Profile{}, now)
+
+		assert.Equal(t, uint64(2), status.Limit)
+		assert.Equal(t, uint64(3), status.Remaining)
+		assert.Equal(t, now, status.NextWalk)
+	})
+
+	t.Run("limited profile reports next walk time", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		profile := &components.Profile{
+			Limits: &components.Limits{
+				LastWalk:  now.Add(-1 * time.Minute),
+				WalkCount: 3,
+			},
+		}
+		status := middleware.WalkCapacity(2, mockItemProvider, profile, now)
+
+		assert.Equal(t, uint64(0), status.Remaining)
+		assert.Equal(t, profile.Limits.LastWalk.Add(30*time.Minute), status.NextWalk)
+	})
+
+	t.Run("partially used profile reports remaining walks", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		profile := &components.Profile{
+			Limits: &components.Limits{
+				LastWalk:  now.Add(-1 * time.Minute),
+				WalkCount: 1,
+			},
+		}
+		status := middleware.WalkCapacity(2, mockItemProvider, profile, now)
+
+		assert.Equal(t, uint64(2), status.Remaining)
+	})
+}
+
+func TestWalkLimiter_Burst(t *testing.T) {
+	t.Parallel()
+
+	limiter := middleware.WalkLimiter(12, 2, mocks.NewMockItemProvider(t))
+
+	result, err := deadenz.RunWithMiddleware(
+		context.Background(),
+		deadenz.WalkCommandType,
+		&components.Profile{Active: &components.Character{}},
+		nil,
+		[]deadenz.Middleware{deadenz.PreRunMiddleware(limiter), stubCommand},
+		deadenz.WithRepeatUntilStopped(),
+	)
+
+	require.NoError(t, err)
+	require.ErrorIs(t, result.Stopped, middleware.ErrBurstExceeded)
+
+	assert.Equal(t, uint(2), result.Steps)
+	assert.Equal(t, uint64(2), result.Profile.Limits.WalkCount)
+}
@@ -56,6 +56,34 @@ func DecodeJSONEvent(data []byte) (components.Event, error) {
 			return nil, err
 		}
 
+		return event, nil
+	case components.EventTypeRest:
+		var event events.RestEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return nil, err
+		}
+
+		return event, nil
+	case components.EventTypeCraft:
+		var event events.CraftEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return nil, err
+		}
+
+		return event, nil
+	case components.EventTypeLevelUp:
+		var event events.LevelUpEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return nil, err
+		}
+
+		return event, nil
+	case components.EventTypeXPMilestone:
+		var event events.XPMilestoneEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return nil, err
+		}
+
 		return event, nil
 	default:
 		return nil, errors.New("unknown event type")
@@ -12,6 +12,10 @@ func ItemsFromJSON(b []byte) ([]components.Item, error) {
 	type jsonItem struct {
 		Name      string                `json:"name"`
 		Findable  bool                  `json:"findable"`
+		StackSize uint32                `json:"stack_size,omitempty"`
+		Weight    uint32                `json:"weight,omitempty"`
+		Rest      uint32                `json:"rest,omitempty"`
+		Rare      bool                  `json:"rare,omitempty"`
 		Usability *components.Usability `json:"usability,omitempty"`
 		Mutators  []json.RawMessage     `json:"mutators,omitempty"`
 	}
@@ -45,6 +49,8 @@ func ItemsFromJSON(b []byte) ([]components.Item, error) {
 				mutator = asStatMutator
 			case "backpack_limit":
 				mutator = asBackpackLimitMutator
+			case "carry_capacity":
+				mutator = asCarryCapacityMutator
 			default:
 				return nil, errors.New("unrecognized mutator type")
 			}
@@ -58,6 +64,10 @@ func ItemsFromJSON(b []byte) ([]components.Item, error) {
 			Type:      components.ItemType(idx + 1),
 			Name:      item.Name,
 			Findable:  item.Findable,
+			StackSize: item.StackSize,
+			Weight:    item.Weight,
+			Rest:      item.Rest,
+			Rare:      item.Rare,
 			Usability: item.Usability,
 			Mutators:  mutators,
 		}
@@ -106,3 +116,16 @@ func asBackpackLimitMutator(data []byte) (components.MutatorFunc, error) {
 
 	return components.BackpackLimitMutator(mut.Limit), nil
 }
+
+func asCarryCapacityMutator(data []byte) (components.MutatorFunc, error) {
+	type mutator struct {
+		Capacity uint32 `json:"capacity"`
+	}
+
+	var mut mutator
+	if err := json.Unmarshal(data, &mut); err != nil {
+		return nil, err
+	}
+
+	return components.CarryCapacityMutator(mut.Capacity), nil
+}
@@ -0,0 +1,71 @@
+package parse
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/ciphermountain/deadenz/pkg/components"
+)
+
+func RecipesFromJSON(b []byte) ([]components.Recipe, error) {
+	type jsonIngredient struct {
+		Item     uint64 `json:"item"`
+		Quantity uint32 `json:"quantity"`
+	}
+
+	type jsonStats struct {
+		Wit   int `json:"wit"`
+		Skill int `json:"skill"`
+		Humor int `json:"humor"`
+	}
+
+	type jsonRecipe struct {
+		Name     string           `json:"name"`
+		Inputs   []jsonIngredient `json:"inputs"`
+		Output   jsonIngredient   `json:"output"`
+		Requires *jsonStats       `json:"requires,omitempty"`
+	}
+
+	var loaded []jsonRecipe
+	if err := json.Unmarshal(b, &loaded); err != nil {
+		return nil, err
+	}
+
+	recipes := make([]components.Recipe, len(loaded))
+
+	for idx, recipe := range loaded {
+		if len(recipe.Inputs) == 0 {
+			return nil, errors.New("recipe requires at least one input")
+		}
+
+		inputs := make([]components.Ingredient, len(recipe.Inputs))
+		for idx, input := range recipe.Inputs {
+			inputs[idx] = components.Ingredient{
+				Item:     components.ItemType(input.Item),
+				Quantity: max(input.Quantity, 1),
+			}
+		}
+
+		var requires *components.Stats
+		if recipe.Requires != nil {
+			requires = &components.Stats{
+				Wit:   recipe.Requires.Wit,
+				Skill: recipe.Requires.Skill,
+				Humor: recipe.Requires.Humor,
+			}
+		}
+
+		recipes[idx] = components.Recipe{
+			Type:   components.RecipeType(idx + 1),
+			Name:   recipe.Name,
+			Inputs: inputs,
+			Output: components.Ingredient{
+				Item:     components.ItemType(recipe.Output.Item),
+				Quantity: max(recipe.Output.Quantity, 1),
+			},
+			Requires: requires,
+		}
+	}
+
+	return recipes, nil
+}
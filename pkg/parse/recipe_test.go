@@ -0,0 +1,102 @@
+package parse_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ciphermountain/deadenz/pkg/components"
+	"github.com/ciphermountain/deadenz/pkg/parse"
+)
+
+func TestRecipesFromJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name string
+			data string
+		}{
+			{name: "invalid json", data: `{"name":`},
+			{name: "recipe without inputs", data: `[{"name":"nothing","output":{"item":3}}]`},
+		}
+
+		for _, test := range tests {
+			test := test
+
+			t.Run(test.name, func(t *testing.T) {
+				t.Parallel()
+
+				_, err := parse.RecipesFromJSON([]byte(test.data))
+
+				assert.Error(t, err)
+			})
+		}
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		data := `[
+			{"name":"axe","inputs":[{"item":1,"quantity":2},{"item":2}],"output":{"item":3}},
+			{"name":"fine axe","inputs":[{"item":3}],"output":{"item":4,"quantity":2},"requires":{"skill":3}}
+		]`
+
+		recipes, err := parse.RecipesFromJSON([]byte(data))
+
+		require.NoError(t, err)
+		assert.Equal(t, []components.Recipe{
+			{
+				Type: 1,
+				Name: "axe",
+				Inputs: []components.Ingredient{
+					{Item: 1, Quantity: 2},
+					// quantities default to one
+					{Item: 2, Quantity: 1},
+				},
+				Output: components.Ingredient{Item: 3, Quantity: 1},
+			},
+			{
+				Type:     2,
+				Name:     "fine axe",
+				Inputs:   []components.Ingredient{{Item: 3, Quantity: 1}},
+				Output:   components.Ingredient{Item: 4, Quantity: 2},
+				Requires: &components.Stats{Skill: 3},
+			},
+		}, recipes)
+	})
+}
+
+func TestRecipesFromJSON_DefaultAssets(t *testing.T) {
+	t.Parallel()
+
+	recipeData, err := os.ReadFile("../../assets/default_recipes.json")
+	require.NoError(t, err)
+
+	itemData, err := os.ReadFile("../../assets/default_items.json")
+	require.NoError(t, err)
+
+	recipes, err := parse.RecipesFromJSON(recipeData)
+	require.NoError(t, err)
+	require.NotEmpty(t, recipes)
+
+	items, err := parse.ItemsFromJSON(itemData)
+	require.NoError(t, err)
+
+	known := make(map[components.ItemType]struct{}, len(items))
+	for _, item := range items {
+		known[item.Type] = struct{}{}
+	}
+
+	for _, recipe := range recipes {
+		for _, input := range recipe.Inputs {
+			assert.Contains(t, known, input.Item, "%s uses an unknown item", recipe.Name)
+		}
+
+		assert.Contains(t, known, recipe.Output.Item, "%s makes an unknown item", recipe.Name)
+	}
+}
@@ -77,6 +77,7 @@ const (
 	AssetType_LiveMutationAsset AssetType = 4
 	AssetType_DieMutationAsset  AssetType = 5
 	AssetType_EncounterAsset    AssetType = 6
+	AssetType_RecipeAsset       AssetType = 7
 )
 
 // Enum value maps for AssetType.
@@ -89,6 +90,7 @@ var (
 		4: "LiveMutationAsset",
 		5: "DieMutationAsset",
 		6: "EncounterAsset",
+		7: "RecipeAsset",
 	}
 	AssetType_value = map[string]int32{
 		"ItemAsset":         0,
@@ -98,6 +100,7 @@ var (
 		"LiveMutationAsset": 4,
 		"DieMutationAsset":  5,
 		"EncounterAsset":    6,
+		"RecipeAsset":       7,
 	}
 )
 
@@ -128,16 +131,125 @@ func (AssetType) EnumDescriptor() ([]byte, []int) {
 	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{1}
 }
 
+// ErrorCode identifies the failure of a request so that clients do not depend on messages
+type ErrorCode int32
+
+const (
+	ErrorCode_UnknownError        ErrorCode = 0
+	ErrorCode_NotSpawnedIn        ErrorCode = 1
+	ErrorCode_AlreadySpawnedIn    ErrorCode = 2
+	ErrorCode_WalkTooMuch         ErrorCode = 3
+	ErrorCode_RateLimited         ErrorCode = 4
+	ErrorCode_NilProfile          ErrorCode = 5
+	ErrorCode_UnrecognizedCommand ErrorCode = 6
+	ErrorCode_NotRepeatable       ErrorCode = 7
+	ErrorCode_CharacterDied       ErrorCode = 8
+	ErrorCode_BackpackTooSmall    ErrorCode = 10
+	ErrorCode_UnknownRecipe       ErrorCode = 11
+	ErrorCode_MissingIngredients  ErrorCode = 12
+	ErrorCode_RecipeRequirements  ErrorCode = 13
+	ErrorCode_WellRested          ErrorCode = 14
+	ErrorCode_NotEnoughTokens     ErrorCode = 15
+	ErrorCode_NotFood             ErrorCode = 16
+	ErrorCode_FoodNotInBackpack   ErrorCode = 17
+	ErrorCode_DeathNotApplicable  ErrorCode = 18
+	ErrorCode_AssetUnavailable    ErrorCode = 19
+	ErrorCode_RestCooldown        ErrorCode = 20
+	ErrorCode_BurstExceeded       ErrorCode = 21
+)
+
+// Enum value maps for ErrorCode.
+var (
+	ErrorCode_name = map[int32]string{
+		0:  "UnknownError",
+		1:  "NotSpawnedIn",
+		2:  "AlreadySpawnedIn",
+		3:  "WalkTooMuch",
+		4:  "RateLimited",
+		5:  "NilProfile",
+		6:  "UnrecognizedCommand",
+		7:  "NotRepeatable",
+		8:  "CharacterDied",
+		10: "BackpackTooSmall",
+		11: "UnknownRecipe",
+		12: "MissingIngredients",
+		13: "RecipeRequirements",
+		14: "WellRested",
+		15: "NotEnoughTokens",
+		16: "NotFood",
+		17: "FoodNotInBackpack",
+		18: "DeathNotApplicable",
+		19: "AssetUnavailable",
+		20: "RestCooldown",
+		21: "BurstExceeded",
+	}
+	ErrorCode_value = map[string]int32{
+		"UnknownError":        0,
+		"NotSpawnedIn":        1,
+		"AlreadySpawnedIn":    2,
+		"WalkTooMuch":         3,
+		"RateLimited":         4,
+		"NilProfile":          5,
+		"UnrecognizedCommand": 6,
+		"NotRepeatable":       7,
+		"CharacterDied":       8,
+		"BackpackTooSmall":    10,
+		"UnknownRecipe":       11,
+		"MissingIngredients":  12,
+		"RecipeRequirements":  13,
+		"WellRested":          14,
+		"NotEnoughTokens":     15,
+		"NotFood":             16,
+		"FoodNotInBackpack":   17,
+		"DeathNotApplicable":  18,
+		"AssetUnavailable":    19,
+		"RestCooldown":        20,
+		"BurstExceeded":       21,
+	}
+)
+
+func (x ErrorCode) Enum() *ErrorCode {
+	p := new(ErrorCode)
+	*p = x
+	return p
+}
+
+func (x ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_proto_core_core_proto_enumTypes[2].Descriptor()
+}
+
+func (ErrorCode) Type() protoreflect.EnumType {
+	return &file_pkg_proto_core_core_proto_enumTypes[2]
+}
+
+func (x ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCode.Descriptor instead.
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{2}
+}
+
 type RunRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Profile *Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	// the typed commands are kept for older clients and resolve to the named command they encode as
+	//
 	// Types that are assignable to Command:
 	//
 	//	*RunRequest_Walk
 	//	*RunRequest_Spawnin
+	//	*RunRequest_Craft
+	//	*RunRequest_Rest
+	//	*RunRequest_Named
 	Command isRunRequest_Command `protobuf_oneof:"command"`
 }
 
@@ -201,6 +313,27 @@ func (x *RunRequest) GetSpawnin() *SpawninCommand {
 	return nil
 }
 
+func (x *RunRequest) GetCraft() *CraftCommand {
+	if x, ok := x.GetCommand().(*RunRequest_Craft); ok {
+		return x.Craft
+	}
+	return nil
+}
+
+func (x *RunRequest) GetRest() *RestCommand {
+	if x, ok := x.GetCommand().(*RunRequest_Rest); ok {
+		return x.Rest
+	}
+	return nil
+}
+
+func (x *RunRequest) GetNamed() *NamedCommand {
+	if x, ok := x.GetCommand().(*RunRequest_Named); ok {
+		return x.Named
+	}
+	return nil
+}
+
 type isRunRequest_Command interface {
 	isRunRequest_Command()
 }
@@ -213,20 +346,98 @@ type RunRequest_Spawnin struct {
 	Spawnin *SpawninCommand `protobuf:"bytes,3,opt,name=spawnin,proto3,oneof"`
 }
 
+type RunRequest_Craft struct {
+	Craft *CraftCommand `protobuf:"bytes,4,opt,name=craft,proto3,oneof"`
+}
+
+type RunRequest_Rest struct {
+	Rest *RestCommand `protobuf:"bytes,5,opt,name=rest,proto3,oneof"`
+}
+
+type RunRequest_Named struct {
+	Named *NamedCommand `protobuf:"bytes,6,opt,name=named,proto3,oneof"`
+}
+
 func (*RunRequest_Walk) isRunRequest_Command() {}
 
 func (*RunRequest_Spawnin) isRunRequest_Command() {}
 
+func (*RunRequest_Craft) isRunRequest_Command() {}
+
+func (*RunRequest_Rest) isRunRequest_Command() {}
+
+func (*RunRequest_Named) isRunRequest_Command() {}
+
+// NamedCommand runs any registered command by name with the arguments encoded by the command
+type NamedCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Args map[string]string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *NamedCommand) Reset() {
+	*x = NamedCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_core_core_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamedCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamedCommand) ProtoMessage() {}
+
+func (x *NamedCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_core_core_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamedCommand.ProtoReflect.Descriptor instead.
+func (*NamedCommand) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NamedCommand) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NamedCommand) GetArgs() map[string]string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+// WalkCommand walks once unless steps or until is set
 type WalkCommand struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Steps uint32 `protobuf:"varint,1,opt,name=steps,proto3" json:"steps,omitempty"`
+	Until bool   `protobuf:"varint,2,opt,name=until,proto3" json:"until,omitempty"`
 }
 
 func (x *WalkCommand) Reset() {
 	*x = WalkCommand{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_core_core_proto_msgTypes[1]
+		mi := &file_pkg_proto_core_core_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -239,7 +450,7 @@ func (x *WalkCommand) String() string {
 func (*WalkCommand) ProtoMessage() {}
 
 func (x *WalkCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_core_core_proto_msgTypes[1]
+	mi := &file_pkg_proto_core_core_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -252,7 +463,21 @@ func (x *WalkCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalkCommand.ProtoReflect.Descriptor instead.
 func (*WalkCommand) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{1}
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WalkCommand) GetSteps() uint32 {
+	if x != nil {
+		return x.Steps
+	}
+	return 0
+}
+
+func (x *WalkCommand) GetUntil() bool {
+	if x != nil {
+		return x.Until
+	}
+	return false
 }
 
 type SpawninCommand struct {
@@ -264,7 +489,7 @@ type SpawninCommand struct {
 func (x *SpawninCommand) Reset() {
 	*x = SpawninCommand{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_core_core_proto_msgTypes[2]
+		mi := &file_pkg_proto_core_core_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -277,7 +502,7 @@ func (x *SpawninCommand) String() string {
 func (*SpawninCommand) ProtoMessage() {}
 
 func (x *SpawninCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_core_core_proto_msgTypes[2]
+	mi := &file_pkg_proto_core_core_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -290,9 +515,138 @@ func (x *SpawninCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpawninCommand.ProtoReflect.Descriptor instead.
 func (*SpawninCommand) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{2}
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{3}
+}
+
+type CraftCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recipe uint64 `protobuf:"varint,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
+}
+
+func (x *CraftCommand) Reset() {
+	*x = CraftCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_core_core_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CraftCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CraftCommand) ProtoMessage() {}
+
+func (x *CraftCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_core_core_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CraftCommand.ProtoReflect.Descriptor instead.
+func (*CraftCommand) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CraftCommand) GetRecipe() uint64 {
+	if x != nil {
+		return x.Recipe
+	}
+	return 0
+}
+
+// RestCommand rests by waiting when no payment is set
+type RestCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Payment:
+	//
+	//	*RestCommand_Currency
+	//	*RestCommand_Food
+	Payment isRestCommand_Payment `protobuf_oneof:"payment"`
+}
+
+func (x *RestCommand) Reset() {
+	*x = RestCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_core_core_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestCommand) ProtoMessage() {}
+
+func (x *RestCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_core_core_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestCommand.ProtoReflect.Descriptor instead.
+func (*RestCommand) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{5}
+}
+
+func (m *RestCommand) GetPayment() isRestCommand_Payment {
+	if m != nil {
+		return m.Payment
+	}
+	return nil
+}
+
+func (x *RestCommand) GetCurrency() bool {
+	if x, ok := x.GetPayment().(*RestCommand_Currency); ok {
+		return x.Currency
+	}
+	return false
+}
+
+func (x *RestCommand) GetFood() uint64 {
+	if x, ok := x.GetPayment().(*RestCommand_Food); ok {
+		return x.Food
+	}
+	return 0
 }
 
+type isRestCommand_Payment interface {
+	isRestCommand_Payment()
+}
+
+type RestCommand_Currency struct {
+	Currency bool `protobuf:"varint,1,opt,name=currency,proto3,oneof"`
+}
+
+type RestCommand_Food struct {
+	Food uint64 `protobuf:"varint,2,opt,name=food,proto3,oneof"`
+}
+
+func (*RestCommand_Currency) isRestCommand_Payment() {}
+
+func (*RestCommand_Food) isRestCommand_Payment() {}
+
 type LoadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -309,7 +663,7 @@ type LoadRequest struct {
 func (x *LoadRequest) Reset() {
 	*x = LoadRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_core_core_proto_msgTypes[3]
+		mi := &file_pkg_proto_core_core_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -322,7 +676,7 @@ func (x *LoadRequest) String() string {
 func (*LoadRequest) ProtoMessage() {}
 
 func (x *LoadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_core_core_proto_msgTypes[3]
+	mi := &file_pkg_proto_core_core_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -335,7 +689,7 @@ func (x *LoadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoadRequest.ProtoReflect.Descriptor instead.
 func (*LoadRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{3}
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *LoadRequest) GetType() AssetType {
@@ -393,7 +747,7 @@ type FileLoader struct {
 func (x *FileLoader) Reset() {
 	*x = FileLoader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_core_core_proto_msgTypes[4]
+		mi := &file_pkg_proto_core_core_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -406,7 +760,7 @@ func (x *FileLoader) String() string {
 func (*FileLoader) ProtoMessage() {}
 
 func (x *FileLoader) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_core_core_proto_msgTypes[4]
+	mi := &file_pkg_proto_core_core_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,7 +773,7 @@ func (x *FileLoader) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileLoader.ProtoReflect.Descriptor instead.
 func (*FileLoader) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{4}
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *FileLoader) GetPath() string {
@@ -440,7 +794,7 @@ type SQLLoader struct {
 func (x *SQLLoader) Reset() {
 	*x = SQLLoader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_core_core_proto_msgTypes[5]
+		mi := &file_pkg_proto_core_core_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -453,7 +807,7 @@ func (x *SQLLoader) String() string {
 func (*SQLLoader) ProtoMessage() {}
 
 func (x *SQLLoader) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_core_core_proto_msgTypes[5]
+	mi := &file_pkg_proto_core_core_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,7 +820,7 @@ func (x *SQLLoader) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLLoader.ProtoReflect.Descriptor instead.
 func (*SQLLoader) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{5}
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SQLLoader) GetDsn() string {
@@ -487,7 +841,7 @@ type AssetRequest struct {
 func (x *AssetRequest) Reset() {
 	*x = AssetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_core_core_proto_msgTypes[6]
+		mi := &file_pkg_proto_core_core_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -500,7 +854,7 @@ func (x *AssetRequest) String() string {
 func (*AssetRequest) ProtoMessage() {}
 
 func (x *AssetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_core_core_proto_msgTypes[6]
+	mi := &file_pkg_proto_core_core_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -513,7 +867,7 @@ func (x *AssetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssetRequest.ProtoReflect.Descriptor instead.
 func (*AssetRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{6}
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *AssetRequest) GetType() AssetType {
@@ -523,33 +877,31 @@ func (x *AssetRequest) GetType() AssetType {
 	return AssetType_ItemAsset
 }
 
-type RunResponse struct {
+type StatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response *Response `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Profile  *Profile  `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
-	Events   []string  `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	Profile *Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 }
 
-func (x *RunResponse) Reset() {
-	*x = RunResponse{}
+func (x *StatusRequest) Reset() {
+	*x = StatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_core_core_proto_msgTypes[7]
+		mi := &file_pkg_proto_core_core_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RunResponse) String() string {
+func (x *StatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunResponse) ProtoMessage() {}
+func (*StatusRequest) ProtoMessage() {}
 
-func (x *RunResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_core_core_proto_msgTypes[7]
+func (x *StatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_core_core_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -560,45 +912,635 @@ func (x *RunResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RunResponse.ProtoReflect.Descriptor instead.
-func (*RunResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *RunResponse) GetResponse() *Response {
-	if x != nil {
-		return x.Response
-	}
-	return nil
+// Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
+func (*StatusRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *RunResponse) GetProfile() *Profile {
+func (x *StatusRequest) GetProfile() *Profile {
 	if x != nil {
 		return x.Profile
 	}
 	return nil
 }
 
-func (x *RunResponse) GetEvents() []string {
-	if x != nil {
-		return x.Events
-	}
-	return nil
-}
-
-type Response struct {
+type StatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response   *Response   `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	ActiveItem *Item       `protobuf:"bytes,2,opt,name=activeItem,proto3,oneof" json:"activeItem,omitempty"`
+	Walk       *WalkStatus `protobuf:"bytes,3,opt,name=walk,proto3,oneof" json:"walk,omitempty"`
+	// commands lists the capacity of every rate limited command
+	Commands []*CommandStatus `protobuf:"bytes,4,rep,name=commands,proto3" json:"commands,omitempty"`
+}
+
+func (x *StatusResponse) Reset() {
+	*x = StatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_core_core_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse) ProtoMessage() {}
+
+func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_core_core_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StatusResponse) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *StatusResponse) GetActiveItem() *Item {
+	if x != nil {
+		return x.ActiveItem
+	}
+	return nil
+}
+
+func (x *StatusResponse) GetWalk() *WalkStatus {
+	if x != nil {
+		return x.Walk
+	}
+	return nil
+}
+
+func (x *StatusResponse) GetCommands() []*CommandStatus {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+type WalkStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit     uint64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Remaining uint64 `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	NextWalk  int64  `protobuf:"varint,3,opt,name=nextWalk,proto3" json:"nextWalk,omitempty"`
+}
+
+func (x *WalkStatus) Reset() {
+	*x = WalkStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_core_core_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalkStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalkStatus) ProtoMessage() {}
+
+func (x *WalkStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_core_core_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalkStatus.ProtoReflect.Descriptor instead.
+func (*WalkStatus) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WalkStatus) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *WalkStatus) GetRemaining() uint64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *WalkStatus) GetNextWalk() int64 {
+	if x != nil {
+		return x.NextWalk
+	}
+	return 0
+}
+
+type CommandStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Command   string `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	Capacity  uint64 `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Remaining uint64 `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	NextUse   int64  `protobuf:"varint,4,opt,name=nextUse,proto3" json:"nextUse,omitempty"`
+}
+
+func (x *CommandStatus) Reset() {
+	*x = CommandStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_core_core_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommandStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandStatus) ProtoMessage() {}
+
+func (x *CommandStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_core_core_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandStatus.ProtoReflect.Descriptor instead.
+func (*CommandStatus) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CommandStatus) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *CommandStatus) GetCapacity() uint64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *CommandStatus) GetRemaining() uint64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *CommandStatus) GetNextUse() int64 {
+	if x != nil {
+		return x.NextUse
+	}
+	return 0
+}
+
+type RunResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response *Response    `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Profile  *Profile     `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	Events   []string     `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	Summary  *RunSummary  `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	Diff     *ProfileDiff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// next is the name of the command suggested after the run
+	Next string `protobuf:"bytes,6,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *RunResponse) Reset() {
+	*x = RunResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_core_core_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunResponse) ProtoMessage() {}
+
+func (x *RunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_core_core_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunResponse.ProtoReflect.Descriptor instead.
+func (*RunResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RunResponse) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *RunResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *RunResponse) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *RunResponse) GetSummary() *RunSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *RunResponse) GetDiff() *ProfileDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *RunResponse) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+// ProfileDiff lists the changes a command made to the profile
+type ProfileDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Xp       int64             `protobuf:"varint,1,opt,name=xp,proto3" json:"xp,omitempty"`
+	Currency int64             `protobuf:"varint,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Stats    *Stats            `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	Backpack []*QuantityChange `protobuf:"bytes,4,rep,name=backpack,proto3" json:"backpack,omitempty"`
+	// activeItem is the new active item when activeItemChanged is set and is unset when it was removed
+	ActiveItemChanged bool    `protobuf:"varint,5,opt,name=activeItemChanged,proto3" json:"activeItemChanged,omitempty"`
+	ActiveItem        *uint64 `protobuf:"varint,6,opt,name=activeItem,proto3,oneof" json:"activeItem,omitempty"`
+	// character is the new character when characterChanged is set and is unset when it died
+	CharacterChanged bool       `protobuf:"varint,7,opt,name=characterChanged,proto3" json:"characterChanged,omitempty"`
+	Character        *Character `protobuf:"bytes,8,opt,name=character,proto3" json:"character,omitempty"`
+}
+
+func (x *ProfileDiff) Reset() {
+	*x = ProfileDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_core_core_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProfileDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileDiff) ProtoMessage() {}
+
+func (x *ProfileDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_core_core_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileDiff.ProtoReflect.Descriptor instead.
+func (*ProfileDiff) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ProfileDiff) GetXp() int64 {
+	if x != nil {
+		return x.Xp
+	}
+	return 0
+}
+
+func (x *ProfileDiff) GetCurrency() int64 {
+	if x != nil {
+		return x.Currency
+	}
+	return 0
+}
+
+func (x *ProfileDiff) GetStats() *Stats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *ProfileDiff) GetBackpack() []*QuantityChange {
+	if x != nil {
+		return x.Backpack
+	}
+	return nil
+}
+
+func (x *ProfileDiff) GetActiveItemChanged() bool {
+	if x != nil {
+		return x.ActiveItemChanged
+	}
+	return false
+}
+
+func (x *ProfileDiff) GetActiveItem() uint64 {
+	if x != nil && x.ActiveItem != nil {
+		return *x.ActiveItem
+	}
+	return 0
+}
+
+func (x *ProfileDiff) GetCharacterChanged() bool {
+	if x != nil {
+		return x.CharacterChanged
+	}
+	return false
+}
+
+func (x *ProfileDiff) GetCharacter() *Character {
+	if x != nil {
+		return x.Character
+	}
+	return nil
+}
+
+type QuantityChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     uint64 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Quantity int64  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (x *QuantityChange) Reset() {
+	*x = QuantityChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_core_core_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuantityChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuantityChange) ProtoMessage() {}
+
+func (x *QuantityChange) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_core_core_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuantityChange.ProtoReflect.Descriptor instead.
+func (*QuantityChange) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QuantityChange) GetType() uint64 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *QuantityChange) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// RunSummary aggregates the earnings of every step of a run
+type RunSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Steps    uint32 `protobuf:"varint,1,opt,name=steps,proto3" json:"steps,omitempty"`
+	Xp       uint64 `protobuf:"varint,2,opt,name=xp,proto3" json:"xp,omitempty"`
+	Currency uint64 `protobuf:"varint,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	// stopped is the reason a repeated command ended early
+	Stopped string `protobuf:"bytes,4,opt,name=stopped,proto3" json:"stopped,omitempty"`
+	// stopped_error identifies the reason a repeated command ended early
+	StoppedError *ErrorDetails `protobuf:"bytes,5,opt,name=stopped_error,json=stoppedError,proto3" json:"stopped_error,omitempty"`
+}
+
+func (x *RunSummary) Reset() {
+	*x = RunSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_core_core_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunSummary) ProtoMessage() {}
+
+func (x *RunSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_core_core_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunSummary.ProtoReflect.Descriptor instead.
+func (*RunSummary) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RunSummary) GetSteps() uint32 {
+	if x != nil {
+		return x.Steps
+	}
+	return 0
+}
+
+func (x *RunSummary) GetXp() uint64 {
+	if x != nil {
+		return x.Xp
+	}
+	return 0
+}
+
+func (x *RunSummary) GetCurrency() uint64 {
+	if x != nil {
+		return x.Currency
+	}
+	return 0
+}
+
+func (x *RunSummary) GetStopped() string {
+	if x != nil {
+		return x.Stopped
+	}
+	return ""
+}
+
+func (x *RunSummary) GetStoppedError() *ErrorDetails {
+	if x != nil {
+		return x.StoppedError
+	}
+	return nil
+}
+
+type ErrorDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=core.ErrorCode" json:"code,omitempty"`
+	// retry_after is the number of milliseconds until a limited command is allowed again
+	RetryAfter int64 `protobuf:"varint,2,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+	// command is the name of the limited command
+	Command string `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
+}
+
+func (x *ErrorDetails) Reset() {
+	*x = ErrorDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_core_core_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorDetails) ProtoMessage() {}
+
+func (x *ErrorDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_core_core_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorDetails.ProtoReflect.Descriptor instead.
+func (*ErrorDetails) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ErrorDetails) GetCode() ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return ErrorCode_UnknownError
+}
+
+func (x *ErrorDetails) GetRetryAfter() int64 {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return 0
+}
+
+func (x *ErrorDetails) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+type Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Status  Status `protobuf:"varint,1,opt,name=status,proto3,enum=core.Status" json:"status,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// error is set on failures
+	Error *ErrorDetails `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
 func (x *Response) Reset() {
 	*x = Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_core_core_proto_msgTypes[8]
+		mi := &file_pkg_proto_core_core_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -611,7 +1553,7 @@ func (x *Response) String() string {
 func (*Response) ProtoMessage() {}
 
 func (x *Response) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_core_core_proto_msgTypes[8]
+	mi := &file_pkg_proto_core_core_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -624,7 +1566,7 @@ func (x *Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Response.ProtoReflect.Descriptor instead.
 func (*Response) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{8}
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *Response) GetStatus() Status {
@@ -641,6 +1583,13 @@ func (x *Response) GetMessage() string {
 	return ""
 }
 
+func (x *Response) GetError() *ErrorDetails {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
 type Profile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -652,15 +1601,20 @@ type Profile struct {
 	Active        *Character `protobuf:"bytes,4,opt,name=active,proto3,oneof" json:"active,omitempty"`
 	ActiveItem    *uint64    `protobuf:"varint,5,opt,name=activeItem,proto3,oneof" json:"activeItem,omitempty"`
 	BackpackLimit uint32     `protobuf:"varint,6,opt,name=backpackLimit,proto3" json:"backpackLimit,omitempty"`
-	Backpack      []uint64   `protobuf:"varint,7,rep,packed,name=backpack,proto3" json:"backpack,omitempty"`
-	Stats         *Stats     `protobuf:"bytes,8,opt,name=stats,proto3" json:"stats,omitempty"`
-	Limits        *Limits    `protobuf:"bytes,9,opt,name=limits,proto3,oneof" json:"limits,omitempty"`
+	// backpack is the legacy flat list of item types and is only read to migrate older profiles
+	//
+	// Deprecated: Marked as deprecated in pkg/proto/core/core.proto.
+	Backpack      []uint64     `protobuf:"varint,7,rep,packed,name=backpack,proto3" json:"backpack,omitempty"`
+	Stats         *Stats       `protobuf:"bytes,8,opt,name=stats,proto3" json:"stats,omitempty"`
+	Limits        *Limits      `protobuf:"bytes,9,opt,name=limits,proto3,oneof" json:"limits,omitempty"`
+	Stacks        []*ItemStack `protobuf:"bytes,10,rep,name=stacks,proto3" json:"stacks,omitempty"`
+	CarryCapacity uint32       `protobuf:"varint,11,opt,name=carryCapacity,proto3" json:"carryCapacity,omitempty"`
 }
 
 func (x *Profile) Reset() {
 	*x = Profile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_core_core_proto_msgTypes[9]
+		mi := &file_pkg_proto_core_core_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -673,7 +1627,7 @@ func (x *Profile) String() string {
 func (*Profile) ProtoMessage() {}
 
 func (x *Profile) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_core_core_proto_msgTypes[9]
+	mi := &file_pkg_proto_core_core_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -686,7 +1640,7 @@ func (x *Profile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Profile.ProtoReflect.Descriptor instead.
 func (*Profile) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{9}
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Profile) GetUuid() string {
@@ -731,6 +1685,7 @@ func (x *Profile) GetBackpackLimit() uint32 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in pkg/proto/core/core.proto.
 func (x *Profile) GetBackpack() []uint64 {
 	if x != nil {
 		return x.Backpack
@@ -752,19 +1707,100 @@ func (x *Profile) GetLimits() *Limits {
 	return nil
 }
 
+func (x *Profile) GetStacks() []*ItemStack {
+	if x != nil {
+		return x.Stacks
+	}
+	return nil
+}
+
+func (x *Profile) GetCarryCapacity() uint32 {
+	if x != nil {
+		return x.CarryCapacity
+	}
+	return 0
+}
+
+type ItemStack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     uint64 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Quantity uint32 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Weight   uint32 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *ItemStack) Reset() {
+	*x = ItemStack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_core_core_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ItemStack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemStack) ProtoMessage() {}
+
+func (x *ItemStack) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_core_core_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemStack.ProtoReflect.Descriptor instead.
+func (*ItemStack) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ItemStack) GetType() uint64 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *ItemStack) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ItemStack) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
 type Item struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type uint64 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type      uint64 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StackSize uint32 `protobuf:"varint,3,opt,name=stackSize,proto3" json:"stackSize,omitempty"`
+	Weight    uint32 `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	Rest      uint32 `protobuf:"varint,5,opt,name=rest,proto3" json:"rest,omitempty"`
+	Rare      bool   `protobuf:"varint,6,opt,name=rare,proto3" json:"rare,omitempty"`
 }
 
 func (x *Item) Reset() {
 	*x = Item{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_core_core_proto_msgTypes[10]
+		mi := &file_pkg_proto_core_core_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -777,7 +1813,7 @@ func (x *Item) String() string {
 func (*Item) ProtoMessage() {}
 
 func (x *Item) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_core_core_proto_msgTypes[10]
+	mi := &file_pkg_proto_core_core_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -790,7 +1826,7 @@ func (x *Item) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Item.ProtoReflect.Descriptor instead.
 func (*Item) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{10}
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Item) GetType() uint64 {
@@ -807,6 +1843,168 @@ func (x *Item) GetName() string {
 	return ""
 }
 
+func (x *Item) GetStackSize() uint32 {
+	if x != nil {
+		return x.StackSize
+	}
+	return 0
+}
+
+func (x *Item) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Item) GetRest() uint32 {
+	if x != nil {
+		return x.Rest
+	}
+	return 0
+}
+
+func (x *Item) GetRare() bool {
+	if x != nil {
+		return x.Rare
+	}
+	return false
+}
+
+type Ingredient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item     uint64 `protobuf:"varint,1,opt,name=item,proto3" json:"item,omitempty"`
+	Quantity uint32 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (x *Ingredient) Reset() {
+	*x = Ingredient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_core_core_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ingredient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ingredient) ProtoMessage() {}
+
+func (x *Ingredient) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_core_core_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ingredient.ProtoReflect.Descriptor instead.
+func (*Ingredient) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Ingredient) GetItem() uint64 {
+	if x != nil {
+		return x.Item
+	}
+	return 0
+}
+
+func (x *Ingredient) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type Recipe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     uint64        `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Name     string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Inputs   []*Ingredient `protobuf:"bytes,3,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Output   *Ingredient   `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"`
+	Requires *Stats        `protobuf:"bytes,5,opt,name=requires,proto3,oneof" json:"requires,omitempty"`
+}
+
+func (x *Recipe) Reset() {
+	*x = Recipe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_core_core_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Recipe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recipe) ProtoMessage() {}
+
+func (x *Recipe) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_core_core_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recipe.ProtoReflect.Descriptor instead.
+func (*Recipe) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Recipe) GetType() uint64 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *Recipe) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Recipe) GetInputs() []*Ingredient {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *Recipe) GetOutput() *Ingredient {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *Recipe) GetRequires() *Stats {
+	if x != nil {
+		return x.Requires
+	}
+	return nil
+}
+
 type Character struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -820,7 +2018,7 @@ type Character struct {
 func (x *Character) Reset() {
 	*x = Character{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_core_core_proto_msgTypes[11]
+		mi := &file_pkg_proto_core_core_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -833,7 +2031,7 @@ func (x *Character) String() string {
 func (*Character) ProtoMessage() {}
 
 func (x *Character) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_core_core_proto_msgTypes[11]
+	mi := &file_pkg_proto_core_core_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -846,7 +2044,7 @@ func (x *Character) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Character.ProtoReflect.Descriptor instead.
 func (*Character) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{11}
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *Character) GetType() uint64 {
@@ -883,7 +2081,7 @@ type Stats struct {
 func (x *Stats) Reset() {
 	*x = Stats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_core_core_proto_msgTypes[12]
+		mi := &file_pkg_proto_core_core_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -896,7 +2094,7 @@ func (x *Stats) String() string {
 func (*Stats) ProtoMessage() {}
 
 func (x *Stats) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_core_core_proto_msgTypes[12]
+	mi := &file_pkg_proto_core_core_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,7 +2107,7 @@ func (x *Stats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Stats.ProtoReflect.Descriptor instead.
 func (*Stats) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{12}
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *Stats) GetWit() int32 {
@@ -938,14 +2136,17 @@ type Limits struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LastWalk  int64  `protobuf:"varint,1,opt,name=lastWalk,proto3" json:"lastWalk,omitempty"`
-	WalkCount string `protobuf:"bytes,2,opt,name=walkCount,proto3" json:"walkCount,omitempty"`
+	LastWalk  int64                    `protobuf:"varint,1,opt,name=lastWalk,proto3" json:"lastWalk,omitempty"`
+	WalkCount string                   `protobuf:"bytes,2,opt,name=walkCount,proto3" json:"walkCount,omitempty"`
+	Commands  map[string]*CommandLimit `protobuf:"bytes,3,rep,name=commands,proto3" json:"commands,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// lastRest is the time of the last rest taken without payment
+	LastRest int64 `protobuf:"varint,4,opt,name=lastRest,proto3" json:"lastRest,omitempty"`
 }
 
 func (x *Limits) Reset() {
 	*x = Limits{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_core_core_proto_msgTypes[13]
+		mi := &file_pkg_proto_core_core_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -957,8 +2158,77 @@ func (x *Limits) String() string {
 
 func (*Limits) ProtoMessage() {}
 
-func (x *Limits) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_core_core_proto_msgTypes[13]
+func (x *Limits) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_core_core_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Limits.ProtoReflect.Descriptor instead.
+func (*Limits) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Limits) GetLastWalk() int64 {
+	if x != nil {
+		return x.LastWalk
+	}
+	return 0
+}
+
+func (x *Limits) GetWalkCount() string {
+	if x != nil {
+		return x.WalkCount
+	}
+	return ""
+}
+
+func (x *Limits) GetCommands() map[string]*CommandLimit {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+func (x *Limits) GetLastRest() int64 {
+	if x != nil {
+		return x.LastRest
+	}
+	return 0
+}
+
+type CommandLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Last  int64  `protobuf:"varint,1,opt,name=last,proto3" json:"last,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *CommandLimit) Reset() {
+	*x = CommandLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_core_core_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommandLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandLimit) ProtoMessage() {}
+
+func (x *CommandLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_core_core_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -969,23 +2239,23 @@ func (x *Limits) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Limits.ProtoReflect.Descriptor instead.
-func (*Limits) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CommandLimit.ProtoReflect.Descriptor instead.
+func (*CommandLimit) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *Limits) GetLastWalk() int64 {
+func (x *CommandLimit) GetLast() int64 {
 	if x != nil {
-		return x.LastWalk
+		return x.Last
 	}
 	return 0
 }
 
-func (x *Limits) GetWalkCount() string {
+func (x *CommandLimit) GetCount() uint64 {
 	if x != nil {
-		return x.WalkCount
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
 type AssetResponse struct {
@@ -998,13 +2268,14 @@ type AssetResponse struct {
 	//
 	//	*AssetResponse_Item
 	//	*AssetResponse_Character
+	//	*AssetResponse_Recipe
 	Asset isAssetResponse_Asset `protobuf_oneof:"asset"`
 }
 
 func (x *AssetResponse) Reset() {
 	*x = AssetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_core_core_proto_msgTypes[14]
+		mi := &file_pkg_proto_core_core_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1017,7 +2288,7 @@ func (x *AssetResponse) String() string {
 func (*AssetResponse) ProtoMessage() {}
 
 func (x *AssetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_core_core_proto_msgTypes[14]
+	mi := &file_pkg_proto_core_core_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1030,7 +2301,7 @@ func (x *AssetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssetResponse.ProtoReflect.Descriptor instead.
 func (*AssetResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{14}
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *AssetResponse) GetResponse() *Response {
@@ -1061,6 +2332,13 @@ func (x *AssetResponse) GetCharacter() *CharacterAssetResponse {
 	return nil
 }
 
+func (x *AssetResponse) GetRecipe() *RecipeAssetResponse {
+	if x, ok := x.GetAsset().(*AssetResponse_Recipe); ok {
+		return x.Recipe
+	}
+	return nil
+}
+
 type isAssetResponse_Asset interface {
 	isAssetResponse_Asset()
 }
@@ -1073,10 +2351,16 @@ type AssetResponse_Character struct {
 	Character *CharacterAssetResponse `protobuf:"bytes,3,opt,name=character,proto3,oneof"`
 }
 
+type AssetResponse_Recipe struct {
+	Recipe *RecipeAssetResponse `protobuf:"bytes,4,opt,name=recipe,proto3,oneof"`
+}
+
 func (*AssetResponse_Item) isAssetResponse_Asset() {}
 
 func (*AssetResponse_Character) isAssetResponse_Asset() {}
 
+func (*AssetResponse_Recipe) isAssetResponse_Asset() {}
+
 type ItemAssetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1088,7 +2372,7 @@ type ItemAssetResponse struct {
 func (x *ItemAssetResponse) Reset() {
 	*x = ItemAssetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_core_core_proto_msgTypes[15]
+		mi := &file_pkg_proto_core_core_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1101,7 +2385,7 @@ func (x *ItemAssetResponse) String() string {
 func (*ItemAssetResponse) ProtoMessage() {}
 
 func (x *ItemAssetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_core_core_proto_msgTypes[15]
+	mi := &file_pkg_proto_core_core_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1114,7 +2398,7 @@ func (x *ItemAssetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ItemAssetResponse.ProtoReflect.Descriptor instead.
 func (*ItemAssetResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{15}
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ItemAssetResponse) GetItems() []*Item {
@@ -1135,7 +2419,7 @@ type CharacterAssetResponse struct {
 func (x *CharacterAssetResponse) Reset() {
 	*x = CharacterAssetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_core_core_proto_msgTypes[16]
+		mi := &file_pkg_proto_core_core_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1148,7 +2432,7 @@ func (x *CharacterAssetResponse) String() string {
 func (*CharacterAssetResponse) ProtoMessage() {}
 
 func (x *CharacterAssetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_core_core_proto_msgTypes[16]
+	mi := &file_pkg_proto_core_core_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1161,7 +2445,7 @@ func (x *CharacterAssetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CharacterAssetResponse.ProtoReflect.Descriptor instead.
 func (*CharacterAssetResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{16}
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *CharacterAssetResponse) GetCharacters() []*Character {
@@ -1171,12 +2455,59 @@ func (x *CharacterAssetResponse) GetCharacters() []*Character {
 	return nil
 }
 
+type RecipeAssetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recipes []*Recipe `protobuf:"bytes,1,rep,name=recipes,proto3" json:"recipes,omitempty"`
+}
+
+func (x *RecipeAssetResponse) Reset() {
+	*x = RecipeAssetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_core_core_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecipeAssetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeAssetResponse) ProtoMessage() {}
+
+func (x *RecipeAssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_core_core_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeAssetResponse.ProtoReflect.Descriptor instead.
+func (*RecipeAssetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_core_core_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RecipeAssetResponse) GetRecipes() []*Recipe {
+	if x != nil {
+		return x.Recipes
+	}
+	return nil
+}
+
 var File_pkg_proto_core_core_proto protoreflect.FileDescriptor
 
 var file_pkg_proto_core_core_proto_rawDesc = []byte{
 	0x0a, 0x19, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x72, 0x65,
 	0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x63, 0x6f, 0x72,
-	0x65, 0x22, 0x9b, 0x01, 0x0a, 0x0a, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x22, 0x9c, 0x02, 0x0a, 0x0a, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x27, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
 	0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x77, 0x61, 0x6c,
@@ -1185,89 +2516,249 @@ var file_pkg_proto_core_core_proto_rawDesc = []byte{
 	0x6c, 0x6b, 0x12, 0x30, 0x0a, 0x07, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x69, 0x6e, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x70, 0x61, 0x77, 0x6e,
 	0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x07, 0x73, 0x70, 0x61,
-	0x77, 0x6e, 0x69, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22,
-	0x0d, 0x0a, 0x0b, 0x57, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x10,
-	0x0a, 0x0e, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x22, 0xa1, 0x01, 0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x61,
-	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0a, 0x66,
-	0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x09, 0x73, 0x71, 0x6c,
-	0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x53, 0x51, 0x4c, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52,
-	0x09, 0x73, 0x71, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x6c, 0x6f,
-	0x61, 0x64, 0x65, 0x72, 0x22, 0x20, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x61, 0x64,
-	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x1d, 0x0a, 0x09, 0x53, 0x51, 0x4c, 0x4c, 0x6f, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x64, 0x73, 0x6e, 0x22, 0x33, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x7a, 0x0a, 0x0b, 0x52, 0x75,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x4a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0xd1, 0x02, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x78, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x78, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2c,
-	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x48,
-	0x00, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
-	0x48, 0x01, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x88, 0x01,
-	0x01, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x70, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x70, 0x61,
-	0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x70,
-	0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x70,
-	0x61, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x73, 0x48, 0x02, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x88, 0x01,
-	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0d, 0x0a, 0x0b,
-	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x09, 0x0a, 0x07, 0x5f,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x2e, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x53, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63,
-	0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d,
-	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x22, 0x45, 0x0a, 0x05, 0x53,
-	0x74, 0x61, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x77, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x03, 0x77, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x14, 0x0a, 0x05,
-	0x68, 0x75, 0x6d, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x68, 0x75, 0x6d,
-	0x6f, 0x72, 0x22, 0x42, 0x0a, 0x06, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x6c, 0x61, 0x73, 0x74, 0x57, 0x61, 0x6c, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x6c, 0x61, 0x73, 0x74, 0x57, 0x61, 0x6c, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6b,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x61, 0x6c,
-	0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb1, 0x01, 0x0a, 0x0d, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x77, 0x6e, 0x69, 0x6e, 0x12, 0x2a, 0x0a, 0x05, 0x63, 0x72, 0x61, 0x66, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x72, 0x61, 0x66, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x05, 0x63, 0x72, 0x61, 0x66, 0x74,
+	0x12, 0x27, 0x0a, 0x04, 0x72, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x48, 0x00, 0x52, 0x04, 0x72, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x6e, 0x61, 0x6d,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x4e, 0x61, 0x6d, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x05,
+	0x6e, 0x61, 0x6d, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x22, 0x8d, 0x01, 0x0a, 0x0c, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x41, 0x72, 0x67, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x39, 0x0a, 0x0b, 0x57, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x10, 0x0a, 0x0e, 0x53,
+	0x70, 0x61, 0x77, 0x6e, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x26, 0x0a,
+	0x0c, 0x43, 0x72, 0x61, 0x66, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x22, 0x4c, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x14, 0x0a, 0x04, 0x66, 0x6f, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x00, 0x52, 0x04, 0x66, 0x6f, 0x6f, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65,
+	0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00,
+	0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x09,
+	0x73, 0x71, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x51, 0x4c, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72,
+	0x48, 0x00, 0x52, 0x09, 0x73, 0x71, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x42, 0x08, 0x0a,
+	0x06, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x22, 0x20, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x4c,
+	0x6f, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x1d, 0x0a, 0x09, 0x53, 0x51, 0x4c,
+	0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x73, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x73, 0x6e, 0x22, 0x33, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x38, 0x0a,
+	0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xe1, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x04, 0x77, 0x61, 0x6c, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x57, 0x61, 0x6c,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x01, 0x52, 0x04, 0x77, 0x61, 0x6c, 0x6b, 0x88,
+	0x01, 0x01, 0x12, 0x2f, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x77, 0x61, 0x6c, 0x6b, 0x22, 0x5c, 0x0a, 0x0a, 0x57,
+	0x61, 0x6c, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a,
+	0x08, 0x6e, 0x65, 0x78, 0x74, 0x57, 0x61, 0x6c, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x6e, 0x65, 0x78, 0x74, 0x57, 0x61, 0x6c, 0x6b, 0x22, 0x7d, 0x0a, 0x0d, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x18,
+	0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x55, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x6e, 0x65, 0x78, 0x74, 0x55, 0x73, 0x65, 0x22, 0xe1, 0x01, 0x0a, 0x0b, 0x52, 0x75, 0x6e,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x72,
 	0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x04, 0x69,
-	0x74, 0x65, 0x6d, 0x12, 0x3c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x68,
-	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
-	0x72, 0x42, 0x07, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x22, 0x35, 0x0a, 0x11, 0x49, 0x74,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x75,
+	0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x69,
+	0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x22, 0xcb, 0x02, 0x0a,
+	0x0b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x0e, 0x0a, 0x02,
+	0x78, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x78, 0x70, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x62,
+	0x61, 0x63, 0x6b, 0x70, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x70, 0x61, 0x63, 0x6b, 0x12, 0x2c, 0x0a,
+	0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0a, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x48,
+	0x00, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x88, 0x01, 0x01,
+	0x12, 0x2a, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x68, 0x61, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x09,
+	0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x22, 0x40, 0x0a, 0x0e, 0x51, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xa1, 0x01, 0x0a,
+	0x0a, 0x52, 0x75, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x65, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70,
+	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x78, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x78,
+	0x70, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x70, 0x70,
+	0x65, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x52, 0x0c, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x6e, 0x0a, 0x0c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x12, 0x23, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x22, 0x74, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa4, 0x03, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x78, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x78, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x23, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x70, 0x61, 0x63,
+	0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x62, 0x61,
+	0x63, 0x6b, 0x70, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x62,
+	0x61, 0x63, 0x6b, 0x70, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x03, 0x28, 0x04, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x70, 0x61, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x29,
+	0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x48, 0x02, 0x52, 0x06,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x63, 0x6b, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x73, 0x74, 0x61, 0x63,
+	0x6b, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x61, 0x72, 0x72, 0x79, 0x43, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x61, 0x72, 0x72, 0x79,
+	0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x53, 0x0a,
+	0x09, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x73,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x61, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x72, 0x61, 0x72,
+	0x65, 0x22, 0x3c, 0x0a, 0x0a, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x69,
+	0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22,
+	0xbf, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
+	0x69, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x73, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x73, 0x22, 0x53, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70,
+	0x6c, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x22, 0x45, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x77, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x77, 0x69,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x75, 0x6d, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x68, 0x75, 0x6d, 0x6f, 0x72, 0x22, 0xe7, 0x01,
+	0x0a, 0x06, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74,
+	0x57, 0x61, 0x6c, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74,
+	0x57, 0x61, 0x6c, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x74, 0x1a, 0x4f, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x38, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0xe6, 0x01, 0x0a, 0x0d, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2d, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x3c,
+	0x0a, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
+	0x65, 0x72, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x00, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x06,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x42, 0x07, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x22, 0x35, 0x0a, 0x11, 0x49, 0x74,
 	0x65, 0x6d, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x20, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a,
 	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
@@ -1275,32 +2766,68 @@ var file_pkg_proto_core_core_proto_rawDesc = []byte{
 	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x63,
 	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
-	0x52, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x2a, 0x1d, 0x0a, 0x06,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0b,
-	0x0a, 0x07, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x10, 0x01, 0x2a, 0x97, 0x01, 0x0a, 0x09,
-	0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x74, 0x65,
-	0x6d, 0x41, 0x73, 0x73, 0x65, 0x74, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x72,
-	0x61, 0x63, 0x74, 0x65, 0x72, 0x41, 0x73, 0x73, 0x65, 0x74, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11,
-	0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x69, 0x76, 0x65, 0x4d, 0x75, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x44,
-	0x69, 0x65, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x10,
-	0x05, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x6e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x10, 0x06, 0x32, 0x99, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x61, 0x64, 0x65, 0x6e,
-	0x7a, 0x12, 0x2c, 0x0a, 0x03, 0x52, 0x75, 0x6e, 0x12, 0x10, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x2b, 0x0a, 0x04, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x11, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x4c,
-	0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x06,
-	0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x2f, 0x64,
-	0x65, 0x61, 0x64, 0x65, 0x6e, 0x7a, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x22, 0x3d, 0x0a, 0x13,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x65, 0x52, 0x07, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x73, 0x2a, 0x1d, 0x0a, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0b, 0x0a,
+	0x07, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x10, 0x01, 0x2a, 0xa8, 0x01, 0x0a, 0x09, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x74, 0x65, 0x6d,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x41, 0x73, 0x73, 0x65, 0x74, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x49,
+	0x74, 0x65, 0x6d, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x69, 0x76, 0x65, 0x4d, 0x75, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x69,
+	0x65, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x10, 0x05,
+	0x12, 0x12, 0x0a, 0x0e, 0x45, 0x6e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x10, 0x07, 0x2a, 0xbf, 0x03, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x53, 0x70, 0x61, 0x77,
+	0x6e, 0x65, 0x64, 0x49, 0x6e, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x6c, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x64, 0x49, 0x6e, 0x10, 0x02, 0x12, 0x0f, 0x0a,
+	0x0b, 0x57, 0x61, 0x6c, 0x6b, 0x54, 0x6f, 0x6f, 0x4d, 0x75, 0x63, 0x68, 0x10, 0x03, 0x12, 0x0f,
+	0x0a, 0x0b, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x10, 0x04, 0x12,
+	0x0e, 0x0a, 0x0a, 0x4e, 0x69, 0x6c, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x10, 0x05, 0x12,
+	0x17, 0x0a, 0x13, 0x55, 0x6e, 0x72, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x52,
+	0x65, 0x70, 0x65, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x07, 0x12, 0x11, 0x0a, 0x0d, 0x43,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x44, 0x69, 0x65, 0x64, 0x10, 0x08, 0x12, 0x14,
+	0x0a, 0x10, 0x42, 0x61, 0x63, 0x6b, 0x70, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x6f, 0x53, 0x6d, 0x61,
+	0x6c, 0x6c, 0x10, 0x0a, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x10, 0x0b, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x10, 0x0c, 0x12,
+	0x16, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x10, 0x0d, 0x12, 0x0e, 0x0a, 0x0a, 0x57, 0x65, 0x6c, 0x6c, 0x52,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x10, 0x0e, 0x12, 0x13, 0x0a, 0x0f, 0x4e, 0x6f, 0x74, 0x45, 0x6e,
+	0x6f, 0x75, 0x67, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x10, 0x0f, 0x12, 0x0b, 0x0a, 0x07,
+	0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x6f, 0x64, 0x10, 0x10, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x6f, 0x6f,
+	0x64, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x42, 0x61, 0x63, 0x6b, 0x70, 0x61, 0x63, 0x6b, 0x10, 0x11,
+	0x12, 0x16, 0x0a, 0x12, 0x44, 0x65, 0x61, 0x74, 0x68, 0x4e, 0x6f, 0x74, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x12, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x55, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x13, 0x12, 0x10,
+	0x0a, 0x0c, 0x52, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x10, 0x14,
+	0x12, 0x11, 0x0a, 0x0d, 0x42, 0x75, 0x72, 0x73, 0x74, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65,
+	0x64, 0x10, 0x15, 0x22, 0x04, 0x08, 0x09, 0x10, 0x09, 0x2a, 0x0c, 0x42, 0x61, 0x63, 0x6b, 0x70,
+	0x61, 0x63, 0x6b, 0x46, 0x75, 0x6c, 0x6c, 0x32, 0xd0, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x61, 0x64,
+	0x65, 0x6e, 0x7a, 0x12, 0x2c, 0x0a, 0x03, 0x52, 0x75, 0x6e, 0x12, 0x10, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x2b, 0x0a, 0x04, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x11, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x33,
+	0x0a, 0x06, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x13, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x7a, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1315,59 +2842,105 @@ func file_pkg_proto_core_core_proto_rawDescGZIP() []byte {
 	return file_pkg_proto_core_core_proto_rawDescData
 }
 
-var file_pkg_proto_core_core_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_pkg_proto_core_core_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_pkg_proto_core_core_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_pkg_proto_core_core_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_pkg_proto_core_core_proto_goTypes = []interface{}{
 	(Status)(0),                    // 0: core.Status
 	(AssetType)(0),                 // 1: core.AssetType
-	(*RunRequest)(nil),             // 2: core.RunRequest
-	(*WalkCommand)(nil),            // 3: core.WalkCommand
-	(*SpawninCommand)(nil),         // 4: core.SpawninCommand
-	(*LoadRequest)(nil),            // 5: core.LoadRequest
-	(*FileLoader)(nil),             // 6: core.FileLoader
-	(*SQLLoader)(nil),              // 7: core.SQLLoader
-	(*AssetRequest)(nil),           // 8: core.AssetRequest
-	(*RunResponse)(nil),            // 9: core.RunResponse
-	(*Response)(nil),               // 10: core.Response
-	(*Profile)(nil),                // 11: core.Profile
-	(*Item)(nil),                   // 12: core.Item
-	(*Character)(nil),              // 13: core.Character
-	(*Stats)(nil),                  // 14: core.Stats
-	(*Limits)(nil),                 // 15: core.Limits
-	(*AssetResponse)(nil),          // 16: core.AssetResponse
-	(*ItemAssetResponse)(nil),      // 17: core.ItemAssetResponse
-	(*CharacterAssetResponse)(nil), // 18: core.CharacterAssetResponse
+	(ErrorCode)(0),                 // 2: core.ErrorCode
+	(*RunRequest)(nil),             // 3: core.RunRequest
+	(*NamedCommand)(nil),           // 4: core.NamedCommand
+	(*WalkCommand)(nil),            // 5: core.WalkCommand
+	(*SpawninCommand)(nil),         // 6: core.SpawninCommand
+	(*CraftCommand)(nil),           // 7: core.CraftCommand
+	(*RestCommand)(nil),            // 8: core.RestCommand
+	(*LoadRequest)(nil),            // 9: core.LoadRequest
+	(*FileLoader)(nil),             // 10: core.FileLoader
+	(*SQLLoader)(nil),              // 11: core.SQLLoader
+	(*AssetRequest)(nil),           // 12: core.AssetRequest
+	(*StatusRequest)(nil),          // 13: core.StatusRequest
+	(*StatusResponse)(nil),         // 14: core.StatusResponse
+	(*WalkStatus)(nil),             // 15: core.WalkStatus
+	(*CommandStatus)(nil),          // 16: core.CommandStatus
+	(*RunResponse)(nil),            // 17: core.RunResponse
+	(*ProfileDiff)(nil),            // 18: core.ProfileDiff
+	(*QuantityChange)(nil),         // 19: core.QuantityChange
+	(*RunSummary)(nil),             // 20: core.RunSummary
+	(*ErrorDetails)(nil),           // 21: core.ErrorDetails
+	(*Response)(nil),               // 22: core.Response
+	(*Profile)(nil),                // 23: core.Profile
+	(*ItemStack)(nil),              // 24: core.ItemStack
+	(*Item)(nil),                   // 25: core.Item
+	(*Ingredient)(nil),             // 26: core.Ingredient
+	(*Recipe)(nil),                 // 27: core.Recipe
+	(*Character)(nil),              // 28: core.Character
+	(*Stats)(nil),                  // 29: core.Stats
+	(*Limits)(nil),                 // 30: core.Limits
+	(*CommandLimit)(nil),           // 31: core.CommandLimit
+	(*AssetResponse)(nil),          // 32: core.AssetResponse
+	(*ItemAssetResponse)(nil),      // 33: core.ItemAssetResponse
+	(*CharacterAssetResponse)(nil), // 34: core.CharacterAssetResponse
+	(*RecipeAssetResponse)(nil),    // 35: core.RecipeAssetResponse
+	nil,                            // 36: core.NamedCommand.ArgsEntry
+	nil,                            // 37: core.Limits.CommandsEntry
 }
 var file_pkg_proto_core_core_proto_depIdxs = []int32{
-	11, // 0: core.RunRequest.profile:type_name -> core.Profile
-	3,  // 1: core.RunRequest.walk:type_name -> core.WalkCommand
-	4,  // 2: core.RunRequest.spawnin:type_name -> core.SpawninCommand
-	1,  // 3: core.LoadRequest.type:type_name -> core.AssetType
-	6,  // 4: core.LoadRequest.fileLoader:type_name -> core.FileLoader
-	7,  // 5: core.LoadRequest.sqlLoader:type_name -> core.SQLLoader
-	1,  // 6: core.AssetRequest.type:type_name -> core.AssetType
-	10, // 7: core.RunResponse.response:type_name -> core.Response
-	11, // 8: core.RunResponse.profile:type_name -> core.Profile
-	0,  // 9: core.Response.status:type_name -> core.Status
-	13, // 10: core.Profile.active:type_name -> core.Character
-	14, // 11: core.Profile.stats:type_name -> core.Stats
-	15, // 12: core.Profile.limits:type_name -> core.Limits
-	10, // 13: core.AssetResponse.response:type_name -> core.Response
-	17, // 14: core.AssetResponse.item:type_name -> core.ItemAssetResponse
-	18, // 15: core.AssetResponse.character:type_name -> core.CharacterAssetResponse
-	12, // 16: core.ItemAssetResponse.items:type_name -> core.Item
-	13, // 17: core.CharacterAssetResponse.characters:type_name -> core.Character
-	2,  // 18: core.Deadenz.Run:input_type -> core.RunRequest
-	5,  // 19: core.Deadenz.Load:input_type -> core.LoadRequest
-	8,  // 20: core.Deadenz.Assets:input_type -> core.AssetRequest
-	9,  // 21: core.Deadenz.Run:output_type -> core.RunResponse
-	10, // 22: core.Deadenz.Load:output_type -> core.Response
-	16, // 23: core.Deadenz.Assets:output_type -> core.AssetResponse
-	21, // [21:24] is the sub-list for method output_type
-	18, // [18:21] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	23, // 0: core.RunRequest.profile:type_name -> core.Profile
+	5,  // 1: core.RunRequest.walk:type_name -> core.WalkCommand
+	6,  // 2: core.RunRequest.spawnin:type_name -> core.SpawninCommand
+	7,  // 3: core.RunRequest.craft:type_name -> core.CraftCommand
+	8,  // 4: core.RunRequest.rest:type_name -> core.RestCommand
+	4,  // 5: core.RunRequest.named:type_name -> core.NamedCommand
+	36, // 6: core.NamedCommand.args:type_name -> core.NamedCommand.ArgsEntry
+	1,  // 7: core.LoadRequest.type:type_name -> core.AssetType
+	10, // 8: core.LoadRequest.fileLoader:type_name -> core.FileLoader
+	11, // 9: core.LoadRequest.sqlLoader:type_name -> core.SQLLoader
+	1,  // 10: core.AssetRequest.type:type_name -> core.AssetType
+	23, // 11: core.StatusRequest.profile:type_name -> core.Profile
+	22, // 12: core.StatusResponse.response:type_name -> core.Response
+	25, // 13: core.StatusResponse.activeItem:type_name -> core.Item
+	15, // 14: core.StatusResponse.walk:type_name -> core.WalkStatus
+	16, // 15: core.StatusResponse.commands:type_name -> core.CommandStatus
+	22, // 16: core.RunResponse.response:type_name -> core.Response
+	23, // 17: core.RunResponse.profile:type_name -> core.Profile
+	20, // 18: core.RunResponse.summary:type_name -> core.RunSummary
+	18, // 19: core.RunResponse.diff:type_name -> core.ProfileDiff
+	29, // 20: core.ProfileDiff.stats:type_name -> core.Stats
+	19, // 21: core.ProfileDiff.backpack:type_name -> core.QuantityChange
+	28, // 22: core.ProfileDiff.character:type_name -> core.Character
+	21, // 23: core.RunSummary.stopped_error:type_name -> core.ErrorDetails
+	2,  // 24: core.ErrorDetails.code:type_name -> core.ErrorCode
+	0,  // 25: core.Response.status:type_name -> core.Status
+	21, // 26: core.Response.error:type_name -> core.ErrorDetails
+	28, // 27: core.Profile.active:type_name -> core.Character
+	29, // 28: core.Profile.stats:type_name -> core.Stats
+	30, // 29: core.Profile.limits:type_name -> core.Limits
+	24, // 30: core.Profile.stacks:type_name -> core.ItemStack
+	26, // 31: core.Recipe.inputs:type_name -> core.Ingredient
+	26, // 32: core.Recipe.output:type_name -> core.Ingredient
+	29, // 33: core.Recipe.requires:type_name -> core.Stats
+	37, // 34: core.Limits.commands:type_name -> core.Limits.CommandsEntry
+	22, // 35: core.AssetResponse.response:type_name -> core.Response
+	33, // 36: core.AssetResponse.item:type_name -> core.ItemAssetResponse
+	34, // 37: core.AssetResponse.character:type_name -> core.CharacterAssetResponse
+	35, // 38: core.AssetResponse.recipe:type_name -> core.RecipeAssetResponse
+	25, // 39: core.ItemAssetResponse.items:type_name -> core.Item
+	28, // 40: core.CharacterAssetResponse.characters:type_name -> core.Character
+	27, // 41: core.RecipeAssetResponse.recipes:type_name -> core.Recipe
+	31, // 42: core.Limits.CommandsEntry.value:type_name -> core.CommandLimit
+	3,  // 43: core.Deadenz.Run:input_type -> core.RunRequest
+	9,  // 44: core.Deadenz.Load:input_type -> core.LoadRequest
+	12, // 45: core.Deadenz.Assets:input_type -> core.AssetRequest
+	13, // 46: core.Deadenz.Status:input_type -> core.StatusRequest
+	17, // 47: core.Deadenz.Run:output_type -> core.RunResponse
+	22, // 48: core.Deadenz.Load:output_type -> core.Response
+	32, // 49: core.Deadenz.Assets:output_type -> core.AssetResponse
+	14, // 50: core.Deadenz.Status:output_type -> core.StatusResponse
+	47, // [47:51] is the sub-list for method output_type
+	43, // [43:47] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_core_core_proto_init() }
@@ -1389,7 +2962,7 @@ func file_pkg_proto_core_core_proto_init() {
 			}
 		}
 		file_pkg_proto_core_core_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalkCommand); i {
+			switch v := v.(*NamedCommand); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1401,7 +2974,7 @@ func file_pkg_proto_core_core_proto_init() {
 			}
 		}
 		file_pkg_proto_core_core_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SpawninCommand); i {
+			switch v := v.(*WalkCommand); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1413,7 +2986,7 @@ func file_pkg_proto_core_core_proto_init() {
 			}
 		}
 		file_pkg_proto_core_core_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoadRequest); i {
+			switch v := v.(*SpawninCommand); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1425,7 +2998,7 @@ func file_pkg_proto_core_core_proto_init() {
 			}
 		}
 		file_pkg_proto_core_core_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileLoader); i {
+			switch v := v.(*CraftCommand); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1437,7 +3010,7 @@ func file_pkg_proto_core_core_proto_init() {
 			}
 		}
 		file_pkg_proto_core_core_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SQLLoader); i {
+			switch v := v.(*RestCommand); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1449,7 +3022,7 @@ func file_pkg_proto_core_core_proto_init() {
 			}
 		}
 		file_pkg_proto_core_core_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetRequest); i {
+			switch v := v.(*LoadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1461,7 +3034,7 @@ func file_pkg_proto_core_core_proto_init() {
 			}
 		}
 		file_pkg_proto_core_core_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunResponse); i {
+			switch v := v.(*FileLoader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1473,7 +3046,7 @@ func file_pkg_proto_core_core_proto_init() {
 			}
 		}
 		file_pkg_proto_core_core_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Response); i {
+			switch v := v.(*SQLLoader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1485,7 +3058,7 @@ func file_pkg_proto_core_core_proto_init() {
 			}
 		}
 		file_pkg_proto_core_core_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Profile); i {
+			switch v := v.(*AssetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1497,7 +3070,7 @@ func file_pkg_proto_core_core_proto_init() {
 			}
 		}
 		file_pkg_proto_core_core_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Item); i {
+			switch v := v.(*StatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1509,7 +3082,7 @@ func file_pkg_proto_core_core_proto_init() {
 			}
 		}
 		file_pkg_proto_core_core_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Character); i {
+			switch v := v.(*StatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1521,7 +3094,7 @@ func file_pkg_proto_core_core_proto_init() {
 			}
 		}
 		file_pkg_proto_core_core_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Stats); i {
+			switch v := v.(*WalkStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1533,7 +3106,7 @@ func file_pkg_proto_core_core_proto_init() {
 			}
 		}
 		file_pkg_proto_core_core_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Limits); i {
+			switch v := v.(*CommandStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1545,7 +3118,7 @@ func file_pkg_proto_core_core_proto_init() {
 			}
 		}
 		file_pkg_proto_core_core_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetResponse); i {
+			switch v := v.(*RunResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1557,7 +3130,7 @@ func file_pkg_proto_core_core_proto_init() {
 			}
 		}
 		file_pkg_proto_core_core_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ItemAssetResponse); i {
+			switch v := v.(*ProfileDiff); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1569,6 +3142,186 @@ func file_pkg_proto_core_core_proto_init() {
 			}
 		}
 		file_pkg_proto_core_core_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuantityChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_core_core_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_core_core_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_core_core_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_core_core_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Profile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_core_core_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemStack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_core_core_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_core_core_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ingredient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_core_core_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Recipe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_core_core_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Character); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_core_core_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_core_core_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Limits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_core_core_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_core_core_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_core_core_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemAssetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_core_core_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CharacterAssetResponse); i {
 			case 0:
 				return &v.state
@@ -1580,27 +3333,50 @@ func file_pkg_proto_core_core_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_proto_core_core_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecipeAssetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_pkg_proto_core_core_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*RunRequest_Walk)(nil),
 		(*RunRequest_Spawnin)(nil),
+		(*RunRequest_Craft)(nil),
+		(*RunRequest_Rest)(nil),
+		(*RunRequest_Named)(nil),
+	}
+	file_pkg_proto_core_core_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*RestCommand_Currency)(nil),
+		(*RestCommand_Food)(nil),
 	}
-	file_pkg_proto_core_core_proto_msgTypes[3].OneofWrappers = []interface{}{
+	file_pkg_proto_core_core_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*LoadRequest_FileLoader)(nil),
 		(*LoadRequest_SqlLoader)(nil),
 	}
-	file_pkg_proto_core_core_proto_msgTypes[9].OneofWrappers = []interface{}{}
-	file_pkg_proto_core_core_proto_msgTypes[14].OneofWrappers = []interface{}{
+	file_pkg_proto_core_core_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_pkg_proto_core_core_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_pkg_proto_core_core_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_pkg_proto_core_core_proto_msgTypes[24].OneofWrappers = []interface{}{}
+	file_pkg_proto_core_core_proto_msgTypes[29].OneofWrappers = []interface{}{
 		(*AssetResponse_Item)(nil),
 		(*AssetResponse_Character)(nil),
+		(*AssetResponse_Recipe)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_proto_core_core_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   17,
+			NumEnums:      3,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
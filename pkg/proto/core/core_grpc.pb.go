@@ -25,6 +25,7 @@ type DeadenzClient interface {
 	Run(ctx context.Context, in *RunRequest, opts ...grpc.CallOption) (*RunResponse, error)
 	Load(ctx context.Context, in *LoadRequest, opts ...grpc.CallOption) (*Response, error)
 	Assets(ctx context.Context, in *AssetRequest, opts ...grpc.CallOption) (*AssetResponse, error)
+	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
 }
 
 type deadenzClient struct {
@@ -62,6 +63,15 @@ func (c *deadenzClient) Assets(ctx context.Context, in *AssetRequest, opts ...gr
 	return out, nil
 }
 
+func (c *deadenzClient) Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, "/core.Deadenz/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeadenzServer is the server API for Deadenz service.
 // All implementations must embed UnimplementedDeadenzServer
 // for forward compatibility
@@ -69,6 +79,7 @@ type DeadenzServer interface {
 	Run(context.Context, *RunRequest) (*RunResponse, error)
 	Load(context.Context, *LoadRequest) (*Response, error)
 	Assets(context.Context, *AssetRequest) (*AssetResponse, error)
+	Status(context.Context, *StatusRequest) (*StatusResponse, error)
 	mustEmbedUnimplementedDeadenzServer()
 }
 
@@ -85,6 +96,9 @@ func (UnimplementedDeadenzServer) Load(context.Context, *LoadRequest) (*Response
 func (UnimplementedDeadenzServer) Assets(context.Context, *AssetRequest) (*AssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Assets not implemented")
 }
+func (UnimplementedDeadenzServer) Status(context.Context, *StatusRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
 func (UnimplementedDeadenzServer) mustEmbedUnimplementedDeadenzServer() {}
 
 // UnsafeDeadenzServer may be embedded to opt out of forward compatibility for this service.
@@ -152,6 +166,24 @@ func _Deadenz_Assets_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Deadenz_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeadenzServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/core.Deadenz/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeadenzServer).Status(ctx, req.(*StatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Deadenz_ServiceDesc is the grpc.ServiceDesc for Deadenz service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -171,6 +203,10 @@ var Deadenz_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Assets",
 			Handler:    _Deadenz_Assets_Handler,
 		},
+		{
+			MethodName: "Status",
+			Handler:    _Deadenz_Status_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/proto/core/core.proto",
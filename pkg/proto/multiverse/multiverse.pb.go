@@ -67,6 +67,71 @@ func (Status) EnumDescriptor() ([]byte, []int) {
 	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{0}
 }
 
+// EventType identifies each type of event for subscription filters
+type EventType int32
+
+const (
+	EventType_UnknownEvent        EventType = 0
+	EventType_CharacterDeathEvent EventType = 1
+	EventType_ItemCraftedEvent    EventType = 2
+	EventType_RareItemFoundEvent  EventType = 3
+	EventType_LevelUpEvent        EventType = 4
+	EventType_FirstSpawnEvent     EventType = 5
+	EventType_XPMilestoneEvent    EventType = 6
+	EventType_AnnouncementEvent   EventType = 7
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0: "UnknownEvent",
+		1: "CharacterDeathEvent",
+		2: "ItemCraftedEvent",
+		3: "RareItemFoundEvent",
+		4: "LevelUpEvent",
+		5: "FirstSpawnEvent",
+		6: "XPMilestoneEvent",
+		7: "AnnouncementEvent",
+	}
+	EventType_value = map[string]int32{
+		"UnknownEvent":        0,
+		"CharacterDeathEvent": 1,
+		"ItemCraftedEvent":    2,
+		"RareItemFoundEvent":  3,
+		"LevelUpEvent":        4,
+		"FirstSpawnEvent":     5,
+		"XPMilestoneEvent":    6,
+		"AnnouncementEvent":   7,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_proto_multiverse_multiverse_proto_enumTypes[1].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_pkg_proto_multiverse_multiverse_proto_enumTypes[1]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{1}
+}
+
 type GameEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -74,6 +139,15 @@ type GameEvent struct {
 
 	Uid  string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
 	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// id identifies the event across federated nodes and is assigned by the node that accepts it when empty. A
+	// signed event must have an id which is also used to reject replays.
+	Id string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// key_id names the shared key the event was signed with
+	KeyId string `protobuf:"bytes,4,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// timestamp is when the event was signed in unix milliseconds
+	Timestamp int64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// signature is the HMAC-SHA256 of the event fields with the shared key
+	Signature []byte `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
 func (x *GameEvent) Reset() {
@@ -110,44 +184,1033 @@ func (*GameEvent) Descriptor() ([]byte, []int) {
 
 func (x *GameEvent) GetUid() string {
 	if x != nil {
-		return x.Uid
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *GameEvent) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GameEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GameEvent) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *GameEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *GameEvent) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GameEventBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*GameEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *GameEventBatch) Reset() {
+	*x = GameEventBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameEventBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameEventBatch) ProtoMessage() {}
+
+func (x *GameEventBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameEventBatch.ProtoReflect.Descriptor instead.
+func (*GameEventBatch) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GameEventBatch) GetEvents() []*GameEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Relayed is a change forwarded between peered multiverse nodes
+type Relayed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id uniquely identifies the change so that each node applies it once
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// origin is the node that first accepted the change
+	Origin string `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	// Types that are assignable to Change:
+	//
+	//	*Relayed_GameEvent
+	//	*Relayed_Registration
+	//	*Relayed_Deregistration
+	//	*Relayed_Announcement
+	Change isRelayed_Change `protobuf_oneof:"change"`
+}
+
+func (x *Relayed) Reset() {
+	*x = Relayed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Relayed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Relayed) ProtoMessage() {}
+
+func (x *Relayed) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Relayed.ProtoReflect.Descriptor instead.
+func (*Relayed) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Relayed) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Relayed) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (m *Relayed) GetChange() isRelayed_Change {
+	if m != nil {
+		return m.Change
+	}
+	return nil
+}
+
+func (x *Relayed) GetGameEvent() *GameEvent {
+	if x, ok := x.GetChange().(*Relayed_GameEvent); ok {
+		return x.GameEvent
+	}
+	return nil
+}
+
+func (x *Relayed) GetRegistration() *Registration {
+	if x, ok := x.GetChange().(*Relayed_Registration); ok {
+		return x.Registration
+	}
+	return nil
+}
+
+func (x *Relayed) GetDeregistration() *Deregistration {
+	if x, ok := x.GetChange().(*Relayed_Deregistration); ok {
+		return x.Deregistration
+	}
+	return nil
+}
+
+func (x *Relayed) GetAnnouncement() *Announcement {
+	if x, ok := x.GetChange().(*Relayed_Announcement); ok {
+		return x.Announcement
+	}
+	return nil
+}
+
+type isRelayed_Change interface {
+	isRelayed_Change()
+}
+
+type Relayed_GameEvent struct {
+	GameEvent *GameEvent `protobuf:"bytes,3,opt,name=game_event,json=gameEvent,proto3,oneof"`
+}
+
+type Relayed_Registration struct {
+	Registration *Registration `protobuf:"bytes,4,opt,name=registration,proto3,oneof"`
+}
+
+type Relayed_Deregistration struct {
+	Deregistration *Deregistration `protobuf:"bytes,5,opt,name=deregistration,proto3,oneof"`
+}
+
+type Relayed_Announcement struct {
+	Announcement *Announcement `protobuf:"bytes,6,opt,name=announcement,proto3,oneof"`
+}
+
+func (*Relayed_GameEvent) isRelayed_Change() {}
+
+func (*Relayed_Registration) isRelayed_Change() {}
+
+func (*Relayed_Deregistration) isRelayed_Change() {}
+
+func (*Relayed_Announcement) isRelayed_Change() {}
+
+type SyncRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// node is the node requesting the registry
+	Node string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *SyncRequest) Reset() {
+	*x = SyncRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRequest) ProtoMessage() {}
+
+func (x *SyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRequest.ProtoReflect.Descriptor instead.
+func (*SyncRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SyncRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type RegistrySnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// data is a JSON encoded character registry snapshot
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *RegistrySnapshot) Reset() {
+	*x = RegistrySnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegistrySnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrySnapshot) ProtoMessage() {}
+
+func (x *RegistrySnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrySnapshot.ProtoReflect.Descriptor instead.
+func (*RegistrySnapshot) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RegistrySnapshot) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Registration is signed like a game event when the multiverse requires authentication
+type Registration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid       string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Character uint64 `protobuf:"varint,2,opt,name=character,proto3" json:"character,omitempty"`
+	Id        string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	KeyId     string `protobuf:"bytes,4,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Timestamp int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Signature []byte `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *Registration) Reset() {
+	*x = Registration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Registration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Registration) ProtoMessage() {}
+
+func (x *Registration) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Registration.ProtoReflect.Descriptor instead.
+func (*Registration) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Registration) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Registration) GetCharacter() uint64 {
+	if x != nil {
+		return x.Character
+	}
+	return 0
+}
+
+func (x *Registration) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Registration) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *Registration) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Registration) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// Deregistration is signed like a game event when the multiverse requires authentication
+type Deregistration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid       string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Id        string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	KeyId     string `protobuf:"bytes,3,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Timestamp int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *Deregistration) Reset() {
+	*x = Deregistration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Deregistration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deregistration) ProtoMessage() {}
+
+func (x *Deregistration) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deregistration.ProtoReflect.Descriptor instead.
+func (*Deregistration) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Deregistration) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Deregistration) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Deregistration) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *Deregistration) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Deregistration) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// Filter narrows the events a subscriber receives. Each empty list matches every event.
+type Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// recipients limits events to those caused by the listed players
+	Recipients []string    `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	Types      []EventType `protobuf:"varint,3,rep,packed,name=types,proto3,enum=multiverse.EventType" json:"types,omitempty"`
+	// characters limits events to those about the listed character types
+	Characters []uint64 `protobuf:"varint,4,rep,packed,name=characters,proto3" json:"characters,omitempty"`
+	// resume_from replays retained events starting at the sequence; zero only streams new events
+	ResumeFrom uint64 `protobuf:"varint,5,opt,name=resume_from,json=resumeFrom,proto3" json:"resume_from,omitempty"`
+}
+
+func (x *Filter) Reset() {
+	*x = Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Filter) ProtoMessage() {}
+
+func (x *Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Filter.ProtoReflect.Descriptor instead.
+func (*Filter) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Filter) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Filter) GetRecipients() []string {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *Filter) GetTypes() []EventType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *Filter) GetCharacters() []uint64 {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+func (x *Filter) GetResumeFrom() uint64 {
+	if x != nil {
+		return x.ResumeFrom
+	}
+	return 0
+}
+
+// Event is delivered to players by the fan-out rules of its type
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Type:
+	//
+	//	*Event_CharacterDeath
+	//	*Event_ItemCrafted
+	//	*Event_RareItemFound
+	//	*Event_LevelUp
+	//	*Event_FirstSpawn
+	//	*Event_XpMilestone
+	//	*Event_Announcement
+	Type isEvent_Type `protobuf_oneof:"type"`
+	// sequence orders the events of a player and is used to resume a stream
+	Sequence uint64 `protobuf:"varint,8,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{8}
+}
+
+func (m *Event) GetType() isEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *Event) GetCharacterDeath() *DeathByCharacterType {
+	if x, ok := x.GetType().(*Event_CharacterDeath); ok {
+		return x.CharacterDeath
+	}
+	return nil
+}
+
+func (x *Event) GetItemCrafted() *ItemCrafted {
+	if x, ok := x.GetType().(*Event_ItemCrafted); ok {
+		return x.ItemCrafted
+	}
+	return nil
+}
+
+func (x *Event) GetRareItemFound() *RareItemFound {
+	if x, ok := x.GetType().(*Event_RareItemFound); ok {
+		return x.RareItemFound
+	}
+	return nil
+}
+
+func (x *Event) GetLevelUp() *LevelUp {
+	if x, ok := x.GetType().(*Event_LevelUp); ok {
+		return x.LevelUp
+	}
+	return nil
+}
+
+func (x *Event) GetFirstSpawn() *FirstSpawn {
+	if x, ok := x.GetType().(*Event_FirstSpawn); ok {
+		return x.FirstSpawn
+	}
+	return nil
+}
+
+func (x *Event) GetXpMilestone() *XPMilestone {
+	if x, ok := x.GetType().(*Event_XpMilestone); ok {
+		return x.XpMilestone
+	}
+	return nil
+}
+
+func (x *Event) GetAnnouncement() *Announcement {
+	if x, ok := x.GetType().(*Event_Announcement); ok {
+		return x.Announcement
+	}
+	return nil
+}
+
+func (x *Event) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type isEvent_Type interface {
+	isEvent_Type()
+}
+
+type Event_CharacterDeath struct {
+	// sent to every player of the character type
+	CharacterDeath *DeathByCharacterType `protobuf:"bytes,1,opt,name=character_death,json=characterDeath,proto3,oneof"`
+}
+
+type Event_ItemCrafted struct {
+	// sent to every player
+	ItemCrafted *ItemCrafted `protobuf:"bytes,2,opt,name=item_crafted,json=itemCrafted,proto3,oneof"`
+}
+
+type Event_RareItemFound struct {
+	// sent to every player except the finder
+	RareItemFound *RareItemFound `protobuf:"bytes,3,opt,name=rare_item_found,json=rareItemFound,proto3,oneof"`
+}
+
+type Event_LevelUp struct {
+	// sent to other players of the same character type
+	LevelUp *LevelUp `protobuf:"bytes,4,opt,name=level_up,json=levelUp,proto3,oneof"`
+}
+
+type Event_FirstSpawn struct {
+	// sent to every player except the one who spawned
+	FirstSpawn *FirstSpawn `protobuf:"bytes,5,opt,name=first_spawn,json=firstSpawn,proto3,oneof"`
+}
+
+type Event_XpMilestone struct {
+	// sent to every player except the one who passed it
+	XpMilestone *XPMilestone `protobuf:"bytes,6,opt,name=xp_milestone,json=xpMilestone,proto3,oneof"`
+}
+
+type Event_Announcement struct {
+	// sent to every player
+	Announcement *Announcement `protobuf:"bytes,7,opt,name=announcement,proto3,oneof"`
+}
+
+func (*Event_CharacterDeath) isEvent_Type() {}
+
+func (*Event_ItemCrafted) isEvent_Type() {}
+
+func (*Event_RareItemFound) isEvent_Type() {}
+
+func (*Event_LevelUp) isEvent_Type() {}
+
+func (*Event_FirstSpawn) isEvent_Type() {}
+
+func (*Event_XpMilestone) isEvent_Type() {}
+
+func (*Event_Announcement) isEvent_Type() {}
+
+type DeathByCharacterType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type uint64 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	// message is the death message of the player whose character died
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Uid     string `protobuf:"bytes,3,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *DeathByCharacterType) Reset() {
+	*x = DeathByCharacterType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeathByCharacterType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeathByCharacterType) ProtoMessage() {}
+
+func (x *DeathByCharacterType) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeathByCharacterType.ProtoReflect.Descriptor instead.
+func (*DeathByCharacterType) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeathByCharacterType) GetType() uint64 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *DeathByCharacterType) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeathByCharacterType) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type ItemCrafted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recipe   uint64 `protobuf:"varint,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	Item     uint64 `protobuf:"varint,2,opt,name=item,proto3" json:"item,omitempty"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity uint32 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Uid      string `protobuf:"bytes,5,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *ItemCrafted) Reset() {
+	*x = ItemCrafted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ItemCrafted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemCrafted) ProtoMessage() {}
+
+func (x *ItemCrafted) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemCrafted.ProtoReflect.Descriptor instead.
+func (*ItemCrafted) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ItemCrafted) GetRecipe() uint64 {
+	if x != nil {
+		return x.Recipe
+	}
+	return 0
+}
+
+func (x *ItemCrafted) GetItem() uint64 {
+	if x != nil {
+		return x.Item
+	}
+	return 0
+}
+
+func (x *ItemCrafted) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ItemCrafted) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ItemCrafted) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type RareItemFound struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid  string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Item uint64 `protobuf:"varint,2,opt,name=item,proto3" json:"item,omitempty"`
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RareItemFound) Reset() {
+	*x = RareItemFound{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RareItemFound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RareItemFound) ProtoMessage() {}
+
+func (x *RareItemFound) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RareItemFound.ProtoReflect.Descriptor instead.
+func (*RareItemFound) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RareItemFound) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *RareItemFound) GetItem() uint64 {
+	if x != nil {
+		return x.Item
+	}
+	return 0
+}
+
+func (x *RareItemFound) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type LevelUp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid       string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Character uint64 `protobuf:"varint,2,opt,name=character,proto3" json:"character,omitempty"`
+	Level     uint32 `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *LevelUp) Reset() {
+	*x = LevelUp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LevelUp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LevelUp) ProtoMessage() {}
+
+func (x *LevelUp) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LevelUp.ProtoReflect.Descriptor instead.
+func (*LevelUp) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LevelUp) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *LevelUp) GetCharacter() uint64 {
+	if x != nil {
+		return x.Character
 	}
-	return ""
+	return 0
 }
 
-func (x *GameEvent) GetData() []byte {
+func (x *LevelUp) GetLevel() uint32 {
 	if x != nil {
-		return x.Data
+		return x.Level
 	}
-	return nil
+	return 0
 }
 
-type Filter struct {
+// FirstSpawn is the first time a character type has ever spawned in the multiverse
+type FirstSpawn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid        string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Recipients []string `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	Uid       string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Character uint64 `protobuf:"varint,2,opt,name=character,proto3" json:"character,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *Filter) Reset() {
-	*x = Filter{}
+func (x *FirstSpawn) Reset() {
+	*x = FirstSpawn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[1]
+		mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Filter) String() string {
+func (x *FirstSpawn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Filter) ProtoMessage() {}
+func (*FirstSpawn) ProtoMessage() {}
 
-func (x *Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[1]
+func (x *FirstSpawn) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -158,53 +1221,58 @@ func (x *Filter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Filter.ProtoReflect.Descriptor instead.
-func (*Filter) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use FirstSpawn.ProtoReflect.Descriptor instead.
+func (*FirstSpawn) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Filter) GetUid() string {
+func (x *FirstSpawn) GetUid() string {
 	if x != nil {
 		return x.Uid
 	}
 	return ""
 }
 
-func (x *Filter) GetRecipients() []string {
+func (x *FirstSpawn) GetCharacter() uint64 {
 	if x != nil {
-		return x.Recipients
+		return x.Character
 	}
-	return nil
+	return 0
 }
 
-type Event struct {
+func (x *FirstSpawn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type XPMilestone struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Type:
-	//
-	//	*Event_CharacterDeath
-	Type isEvent_Type `protobuf_oneof:"type"`
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Xp  uint64 `protobuf:"varint,2,opt,name=xp,proto3" json:"xp,omitempty"`
 }
 
-func (x *Event) Reset() {
-	*x = Event{}
+func (x *XPMilestone) Reset() {
+	*x = XPMilestone{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[2]
+		mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Event) String() string {
+func (x *XPMilestone) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event) ProtoMessage() {}
+func (*XPMilestone) ProtoMessage() {}
 
-func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[2]
+func (x *XPMilestone) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -215,60 +1283,50 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event.ProtoReflect.Descriptor instead.
-func (*Event) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use XPMilestone.ProtoReflect.Descriptor instead.
+func (*XPMilestone) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{14}
 }
 
-func (m *Event) GetType() isEvent_Type {
-	if m != nil {
-		return m.Type
+func (x *XPMilestone) GetUid() string {
+	if x != nil {
+		return x.Uid
 	}
-	return nil
+	return ""
 }
 
-func (x *Event) GetCharacterDeath() *DeathByCharacterType {
-	if x, ok := x.GetType().(*Event_CharacterDeath); ok {
-		return x.CharacterDeath
+func (x *XPMilestone) GetXp() uint64 {
+	if x != nil {
+		return x.Xp
 	}
-	return nil
-}
-
-type isEvent_Type interface {
-	isEvent_Type()
-}
-
-type Event_CharacterDeath struct {
-	CharacterDeath *DeathByCharacterType `protobuf:"bytes,1,opt,name=character_death,json=characterDeath,proto3,oneof"`
+	return 0
 }
 
-func (*Event_CharacterDeath) isEvent_Type() {}
-
-type DeathByCharacterType struct {
+type Announcement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type uint64 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *DeathByCharacterType) Reset() {
-	*x = DeathByCharacterType{}
+func (x *Announcement) Reset() {
+	*x = Announcement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[3]
+		mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeathByCharacterType) String() string {
+func (x *Announcement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeathByCharacterType) ProtoMessage() {}
+func (*Announcement) ProtoMessage() {}
 
-func (x *DeathByCharacterType) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[3]
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -279,16 +1337,16 @@ func (x *DeathByCharacterType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeathByCharacterType.ProtoReflect.Descriptor instead.
-func (*DeathByCharacterType) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *DeathByCharacterType) GetType() uint64 {
+func (x *Announcement) GetMessage() string {
 	if x != nil {
-		return x.Type
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
 type Response struct {
@@ -303,7 +1361,7 @@ type Response struct {
 func (x *Response) Reset() {
 	*x = Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[4]
+		mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -316,7 +1374,7 @@ func (x *Response) String() string {
 func (*Response) ProtoMessage() {}
 
 func (x *Response) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[4]
+	mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -329,7 +1387,7 @@ func (x *Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Response.ProtoReflect.Descriptor instead.
 func (*Response) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{4}
+	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Response) GetStatus() Status {
@@ -346,48 +1404,252 @@ func (x *Response) GetMessage() string {
 	return ""
 }
 
+type BatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// responses are in the same order as the events of the batch
+	Responses []*Response `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+}
+
+func (x *BatchResponse) Reset() {
+	*x = BatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResponse) ProtoMessage() {}
+
+func (x *BatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_multiverse_multiverse_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResponse.ProtoReflect.Descriptor instead.
+func (*BatchResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BatchResponse) GetResponses() []*Response {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
 var File_pkg_proto_multiverse_multiverse_proto protoreflect.FileDescriptor
 
 var file_pkg_proto_multiverse_multiverse_proto_rawDesc = []byte{
 	0x0a, 0x25, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x75, 0x6c, 0x74,
 	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65,
-	0x72, 0x73, 0x65, 0x22, 0x31, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3a, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
-	0x74, 0x73, 0x22, 0x5c, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x0f, 0x63,
-	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x61, 0x74, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73,
-	0x65, 0x2e, 0x44, 0x65, 0x61, 0x74, 0x68, 0x42, 0x79, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
-	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63,
-	0x74, 0x65, 0x72, 0x44, 0x65, 0x61, 0x74, 0x68, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x22, 0x2a, 0x0a, 0x14, 0x44, 0x65, 0x61, 0x74, 0x68, 0x42, 0x79, 0x43, 0x68, 0x61, 0x72, 0x61,
-	0x63, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x50, 0x0a, 0x08,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69,
-	0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x1d,
-	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00,
-	0x12, 0x0b, 0x0a, 0x07, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x10, 0x01, 0x32, 0x84, 0x01,
-	0x0a, 0x0a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x10,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x15, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x47, 0x61,
-	0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x14, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76,
-	0x65, 0x72, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x33, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x2e, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x11, 0x2e,
-	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x22, 0x00, 0x30, 0x01, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x2f, 0x64, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x7a, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x75,
-	0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x73, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x3f, 0x0a, 0x0e, 0x47, 0x61,
+	0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2d, 0x0a, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xb9, 0x02, 0x0a, 0x07,
+	0x52, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12,
+	0x36, 0x0a, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09, 0x67, 0x61,
+	0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x0e, 0x64, 0x65, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0e, 0x64,
+	0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a,
+	0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x08, 0x0a,
+	0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x21, 0x0a, 0x0b, 0x53, 0x79, 0x6e, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x26, 0x0a, 0x10, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0xa1, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6b,
+	0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xa8,
+	0x01, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x68,
+	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0xe6, 0x03, 0x0a, 0x05, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x5f, 0x64, 0x65, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x61, 0x74, 0x68, 0x42,
+	0x79, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00,
+	0x52, 0x0e, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x44, 0x65, 0x61, 0x74, 0x68,
+	0x12, 0x3c, 0x0a, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x63, 0x72, 0x61, 0x66, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x72, 0x61, 0x66, 0x74, 0x65, 0x64, 0x48,
+	0x00, 0x52, 0x0b, 0x69, 0x74, 0x65, 0x6d, 0x43, 0x72, 0x61, 0x66, 0x74, 0x65, 0x64, 0x12, 0x43,
+	0x0a, 0x0f, 0x72, 0x61, 0x72, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x66, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x2e, 0x52, 0x61, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x46, 0x6f, 0x75,
+	0x6e, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x61, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x46, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x75, 0x70, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x48, 0x00, 0x52, 0x07, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x55, 0x70, 0x12, 0x39, 0x0a, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x53, 0x70, 0x61,
+	0x77, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x70, 0x61, 0x77, 0x6e,
+	0x12, 0x3c, 0x0a, 0x0c, 0x78, 0x70, 0x5f, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x2e, 0x58, 0x50, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x48,
+	0x00, 0x52, 0x0b, 0x78, 0x70, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x12, 0x3e,
+	0x0a, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0x56, 0x0a, 0x14, 0x44, 0x65, 0x61, 0x74, 0x68, 0x42, 0x79, 0x43, 0x68, 0x61,
+	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x7b, 0x0a, 0x0b, 0x49, 0x74,
+	0x65, 0x6d, 0x43, 0x72, 0x61, 0x66, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x49, 0x0a, 0x0d, 0x52, 0x61, 0x72, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x74,
+	0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x4f, 0x0a, 0x07, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x22, 0x50, 0x0a, 0x0a, 0x46, 0x69, 0x72, 0x73, 0x74, 0x53, 0x70, 0x61, 0x77,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x0b, 0x58, 0x50, 0x4d, 0x69, 0x6c, 0x65, 0x73,
+	0x74, 0x6f, 0x6e, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x78, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x78, 0x70, 0x22, 0x28, 0x0a, 0x0c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x50, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x43, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x2a, 0x1d, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x10, 0x01, 0x2a, 0xb8, 0x01, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x44, 0x65, 0x61, 0x74, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x10, 0x01, 0x12,
+	0x14, 0x0a, 0x10, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x72, 0x61, 0x66, 0x74, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x61, 0x72, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x10, 0x03, 0x12, 0x10, 0x0a,
+	0x0c, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x10, 0x04, 0x12,
+	0x13, 0x0a, 0x0f, 0x46, 0x69, 0x72, 0x73, 0x74, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x58, 0x50, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74,
+	0x6f, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x10, 0x06, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x10,
+	0x07, 0x32, 0x87, 0x04, 0x0a, 0x0a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x12, 0x41, 0x0a, 0x10, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x47, 0x61, 0x6d, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x14, 0x2e, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x47, 0x61,
+	0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x1a, 0x19, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x33, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x2e, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a,
+	0x11, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x12, 0x18, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x14, 0x2e, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0a, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x12, 0x1a, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e,
+	0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x14,
+	0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x08, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x12, 0x18, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x14, 0x2e, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x05, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x13, 0x2e,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x79,
+	0x65, 0x64, 0x1a, 0x14, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x04, 0x53, 0x79,
+	0x6e, 0x63, 0x12, 0x17, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e,
+	0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x00, 0x42, 0x3a, 0x5a, 0x38, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x7a,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -402,28 +1664,67 @@ func file_pkg_proto_multiverse_multiverse_proto_rawDescGZIP() []byte {
 	return file_pkg_proto_multiverse_multiverse_proto_rawDescData
 }
 
-var file_pkg_proto_multiverse_multiverse_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pkg_proto_multiverse_multiverse_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pkg_proto_multiverse_multiverse_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_pkg_proto_multiverse_multiverse_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_pkg_proto_multiverse_multiverse_proto_goTypes = []interface{}{
 	(Status)(0),                  // 0: multiverse.Status
-	(*GameEvent)(nil),            // 1: multiverse.GameEvent
-	(*Filter)(nil),               // 2: multiverse.Filter
-	(*Event)(nil),                // 3: multiverse.Event
-	(*DeathByCharacterType)(nil), // 4: multiverse.DeathByCharacterType
-	(*Response)(nil),             // 5: multiverse.Response
+	(EventType)(0),               // 1: multiverse.EventType
+	(*GameEvent)(nil),            // 2: multiverse.GameEvent
+	(*GameEventBatch)(nil),       // 3: multiverse.GameEventBatch
+	(*Relayed)(nil),              // 4: multiverse.Relayed
+	(*SyncRequest)(nil),          // 5: multiverse.SyncRequest
+	(*RegistrySnapshot)(nil),     // 6: multiverse.RegistrySnapshot
+	(*Registration)(nil),         // 7: multiverse.Registration
+	(*Deregistration)(nil),       // 8: multiverse.Deregistration
+	(*Filter)(nil),               // 9: multiverse.Filter
+	(*Event)(nil),                // 10: multiverse.Event
+	(*DeathByCharacterType)(nil), // 11: multiverse.DeathByCharacterType
+	(*ItemCrafted)(nil),          // 12: multiverse.ItemCrafted
+	(*RareItemFound)(nil),        // 13: multiverse.RareItemFound
+	(*LevelUp)(nil),              // 14: multiverse.LevelUp
+	(*FirstSpawn)(nil),           // 15: multiverse.FirstSpawn
+	(*XPMilestone)(nil),          // 16: multiverse.XPMilestone
+	(*Announcement)(nil),         // 17: multiverse.Announcement
+	(*Response)(nil),             // 18: multiverse.Response
+	(*BatchResponse)(nil),        // 19: multiverse.BatchResponse
 }
 var file_pkg_proto_multiverse_multiverse_proto_depIdxs = []int32{
-	4, // 0: multiverse.Event.character_death:type_name -> multiverse.DeathByCharacterType
-	0, // 1: multiverse.Response.status:type_name -> multiverse.Status
-	1, // 2: multiverse.Multiverse.PublishGameEvent:input_type -> multiverse.GameEvent
-	2, // 3: multiverse.Multiverse.Events:input_type -> multiverse.Filter
-	5, // 4: multiverse.Multiverse.PublishGameEvent:output_type -> multiverse.Response
-	3, // 5: multiverse.Multiverse.Events:output_type -> multiverse.Event
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2,  // 0: multiverse.GameEventBatch.events:type_name -> multiverse.GameEvent
+	2,  // 1: multiverse.Relayed.game_event:type_name -> multiverse.GameEvent
+	7,  // 2: multiverse.Relayed.registration:type_name -> multiverse.Registration
+	8,  // 3: multiverse.Relayed.deregistration:type_name -> multiverse.Deregistration
+	17, // 4: multiverse.Relayed.announcement:type_name -> multiverse.Announcement
+	1,  // 5: multiverse.Filter.types:type_name -> multiverse.EventType
+	11, // 6: multiverse.Event.character_death:type_name -> multiverse.DeathByCharacterType
+	12, // 7: multiverse.Event.item_crafted:type_name -> multiverse.ItemCrafted
+	13, // 8: multiverse.Event.rare_item_found:type_name -> multiverse.RareItemFound
+	14, // 9: multiverse.Event.level_up:type_name -> multiverse.LevelUp
+	15, // 10: multiverse.Event.first_spawn:type_name -> multiverse.FirstSpawn
+	16, // 11: multiverse.Event.xp_milestone:type_name -> multiverse.XPMilestone
+	17, // 12: multiverse.Event.announcement:type_name -> multiverse.Announcement
+	0,  // 13: multiverse.Response.status:type_name -> multiverse.Status
+	18, // 14: multiverse.BatchResponse.responses:type_name -> multiverse.Response
+	2,  // 15: multiverse.Multiverse.PublishGameEvent:input_type -> multiverse.GameEvent
+	3,  // 16: multiverse.Multiverse.PublishGameEvents:input_type -> multiverse.GameEventBatch
+	9,  // 17: multiverse.Multiverse.Events:input_type -> multiverse.Filter
+	7,  // 18: multiverse.Multiverse.Register:input_type -> multiverse.Registration
+	8,  // 19: multiverse.Multiverse.Deregister:input_type -> multiverse.Deregistration
+	17, // 20: multiverse.Multiverse.Announce:input_type -> multiverse.Announcement
+	4,  // 21: multiverse.Multiverse.Relay:input_type -> multiverse.Relayed
+	5,  // 22: multiverse.Multiverse.Sync:input_type -> multiverse.SyncRequest
+	18, // 23: multiverse.Multiverse.PublishGameEvent:output_type -> multiverse.Response
+	19, // 24: multiverse.Multiverse.PublishGameEvents:output_type -> multiverse.BatchResponse
+	10, // 25: multiverse.Multiverse.Events:output_type -> multiverse.Event
+	18, // 26: multiverse.Multiverse.Register:output_type -> multiverse.Response
+	18, // 27: multiverse.Multiverse.Deregister:output_type -> multiverse.Response
+	18, // 28: multiverse.Multiverse.Announce:output_type -> multiverse.Response
+	18, // 29: multiverse.Multiverse.Relay:output_type -> multiverse.Response
+	6,  // 30: multiverse.Multiverse.Sync:output_type -> multiverse.RegistrySnapshot
+	23, // [23:31] is the sub-list for method output_type
+	15, // [15:23] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_multiverse_multiverse_proto_init() }
@@ -445,7 +1746,7 @@ func file_pkg_proto_multiverse_multiverse_proto_init() {
 			}
 		}
 		file_pkg_proto_multiverse_multiverse_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Filter); i {
+			switch v := v.(*GameEventBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -457,7 +1758,7 @@ func file_pkg_proto_multiverse_multiverse_proto_init() {
 			}
 		}
 		file_pkg_proto_multiverse_multiverse_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event); i {
+			switch v := v.(*Relayed); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -469,7 +1770,7 @@ func file_pkg_proto_multiverse_multiverse_proto_init() {
 			}
 		}
 		file_pkg_proto_multiverse_multiverse_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeathByCharacterType); i {
+			switch v := v.(*SyncRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -481,6 +1782,150 @@ func file_pkg_proto_multiverse_multiverse_proto_init() {
 			}
 		}
 		file_pkg_proto_multiverse_multiverse_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrySnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_multiverse_multiverse_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Registration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_multiverse_multiverse_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Deregistration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_multiverse_multiverse_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_multiverse_multiverse_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_multiverse_multiverse_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeathByCharacterType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_multiverse_multiverse_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemCrafted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_multiverse_multiverse_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RareItemFound); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_multiverse_multiverse_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LevelUp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_multiverse_multiverse_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FirstSpawn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_multiverse_multiverse_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XPMilestone); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_multiverse_multiverse_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Announcement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_multiverse_multiverse_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Response); i {
 			case 0:
 				return &v.state
@@ -492,17 +1937,41 @@ func file_pkg_proto_multiverse_multiverse_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_proto_multiverse_multiverse_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_pkg_proto_multiverse_multiverse_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*Relayed_GameEvent)(nil),
+		(*Relayed_Registration)(nil),
+		(*Relayed_Deregistration)(nil),
+		(*Relayed_Announcement)(nil),
+	}
+	file_pkg_proto_multiverse_multiverse_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*Event_CharacterDeath)(nil),
+		(*Event_ItemCrafted)(nil),
+		(*Event_RareItemFound)(nil),
+		(*Event_LevelUp)(nil),
+		(*Event_FirstSpawn)(nil),
+		(*Event_XpMilestone)(nil),
+		(*Event_Announcement)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_proto_multiverse_multiverse_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   5,
+			NumEnums:      2,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,7 +23,19 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MultiverseClient interface {
 	PublishGameEvent(ctx context.Context, in *GameEvent, opts ...grpc.CallOption) (*Response, error)
+	// PublishGameEvents applies a batch of game events in order with a response for each event
+	PublishGameEvents(ctx context.Context, in *GameEventBatch, opts ...grpc.CallOption) (*BatchResponse, error)
 	Events(ctx context.Context, in *Filter, opts ...grpc.CallOption) (Multiverse_EventsClient, error)
+	// Register records the character a player is playing and is acknowledged once the registry is updated
+	Register(ctx context.Context, in *Registration, opts ...grpc.CallOption) (*Response, error)
+	// Deregister removes a player whose character is no longer alive
+	Deregister(ctx context.Context, in *Deregistration, opts ...grpc.CallOption) (*Response, error)
+	// Announce broadcasts a server announcement to every player
+	Announce(ctx context.Context, in *Announcement, opts ...grpc.CallOption) (*Response, error)
+	// Relay applies a change forwarded by a peered multiverse node and forwards it to the other peers
+	Relay(ctx context.Context, in *Relayed, opts ...grpc.CallOption) (*Response, error)
+	// Sync returns the character registry so that a joining node starts with the shared registry
+	Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*RegistrySnapshot, error)
 }
 
 type multiverseClient struct {
@@ -43,6 +55,15 @@ func (c *multiverseClient) PublishGameEvent(ctx context.Context, in *GameEvent,
 	return out, nil
 }
 
+func (c *multiverseClient) PublishGameEvents(ctx context.Context, in *GameEventBatch, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, "/multiverse.Multiverse/PublishGameEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *multiverseClient) Events(ctx context.Context, in *Filter, opts ...grpc.CallOption) (Multiverse_EventsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Multiverse_ServiceDesc.Streams[0], "/multiverse.Multiverse/Events", opts...)
 	if err != nil {
@@ -75,12 +96,69 @@ func (x *multiverseEventsClient) Recv() (*Event, error) {
 	return m, nil
 }
 
+func (c *multiverseClient) Register(ctx context.Context, in *Registration, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/multiverse.Multiverse/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiverseClient) Deregister(ctx context.Context, in *Deregistration, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/multiverse.Multiverse/Deregister", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiverseClient) Announce(ctx context.Context, in *Announcement, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/multiverse.Multiverse/Announce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiverseClient) Relay(ctx context.Context, in *Relayed, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/multiverse.Multiverse/Relay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiverseClient) Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*RegistrySnapshot, error) {
+	out := new(RegistrySnapshot)
+	err := c.cc.Invoke(ctx, "/multiverse.Multiverse/Sync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MultiverseServer is the server API for Multiverse service.
 // All implementations must embed UnimplementedMultiverseServer
 // for forward compatibility
 type MultiverseServer interface {
 	PublishGameEvent(context.Context, *GameEvent) (*Response, error)
+	// PublishGameEvents applies a batch of game events in order with a response for each event
+	PublishGameEvents(context.Context, *GameEventBatch) (*BatchResponse, error)
 	Events(*Filter, Multiverse_EventsServer) error
+	// Register records the character a player is playing and is acknowledged once the registry is updated
+	Register(context.Context, *Registration) (*Response, error)
+	// Deregister removes a player whose character is no longer alive
+	Deregister(context.Context, *Deregistration) (*Response, error)
+	// Announce broadcasts a server announcement to every player
+	Announce(context.Context, *Announcement) (*Response, error)
+	// Relay applies a change forwarded by a peered multiverse node and forwards it to the other peers
+	Relay(context.Context, *Relayed) (*Response, error)
+	// Sync returns the character registry so that a joining node starts with the shared registry
+	Sync(context.Context, *SyncRequest) (*RegistrySnapshot, error)
 	mustEmbedUnimplementedMultiverseServer()
 }
 
@@ -91,9 +169,27 @@ type UnimplementedMultiverseServer struct {
 func (UnimplementedMultiverseServer) PublishGameEvent(context.Context, *GameEvent) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublishGameEvent not implemented")
 }
+func (UnimplementedMultiverseServer) PublishGameEvents(context.Context, *GameEventBatch) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishGameEvents not implemented")
+}
 func (UnimplementedMultiverseServer) Events(*Filter, Multiverse_EventsServer) error {
 	return status.Errorf(codes.Unimplemented, "method Events not implemented")
 }
+func (UnimplementedMultiverseServer) Register(context.Context, *Registration) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedMultiverseServer) Deregister(context.Context, *Deregistration) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deregister not implemented")
+}
+func (UnimplementedMultiverseServer) Announce(context.Context, *Announcement) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Announce not implemented")
+}
+func (UnimplementedMultiverseServer) Relay(context.Context, *Relayed) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Relay not implemented")
+}
+func (UnimplementedMultiverseServer) Sync(context.Context, *SyncRequest) (*RegistrySnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sync not implemented")
+}
 func (UnimplementedMultiverseServer) mustEmbedUnimplementedMultiverseServer() {}
 
 // UnsafeMultiverseServer may be embedded to opt out of forward compatibility for this service.
@@ -125,6 +221,24 @@ func _Multiverse_PublishGameEvent_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Multiverse_PublishGameEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameEventBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiverseServer).PublishGameEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multiverse.Multiverse/PublishGameEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiverseServer).PublishGameEvents(ctx, req.(*GameEventBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Multiverse_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(Filter)
 	if err := stream.RecvMsg(m); err != nil {
@@ -146,6 +260,96 @@ func (x *multiverseEventsServer) Send(m *Event) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Multiverse_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Registration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiverseServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multiverse.Multiverse/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiverseServer).Register(ctx, req.(*Registration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Multiverse_Deregister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Deregistration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiverseServer).Deregister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multiverse.Multiverse/Deregister",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiverseServer).Deregister(ctx, req.(*Deregistration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Multiverse_Announce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Announcement)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiverseServer).Announce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multiverse.Multiverse/Announce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiverseServer).Announce(ctx, req.(*Announcement))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Multiverse_Relay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Relayed)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiverseServer).Relay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multiverse.Multiverse/Relay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiverseServer).Relay(ctx, req.(*Relayed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Multiverse_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiverseServer).Sync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/multiverse.Multiverse/Sync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiverseServer).Sync(ctx, req.(*SyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Multiverse_ServiceDesc is the grpc.ServiceDesc for Multiverse service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -157,6 +361,30 @@ var Multiverse_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PublishGameEvent",
 			Handler:    _Multiverse_PublishGameEvent_Handler,
 		},
+		{
+			MethodName: "PublishGameEvents",
+			Handler:    _Multiverse_PublishGameEvents_Handler,
+		},
+		{
+			MethodName: "Register",
+			Handler:    _Multiverse_Register_Handler,
+		},
+		{
+			MethodName: "Deregister",
+			Handler:    _Multiverse_Deregister_Handler,
+		},
+		{
+			MethodName: "Announce",
+			Handler:    _Multiverse_Announce_Handler,
+		},
+		{
+			MethodName: "Relay",
+			Handler:    _Multiverse_Relay_Handler,
+		},
+		{
+			MethodName: "Sync",
+			Handler:    _Multiverse_Sync_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
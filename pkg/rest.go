@@ -0,0 +1,107 @@
+package deadenz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ciphermountain/deadenz/pkg/components"
+	"github.com/ciphermountain/deadenz/pkg/events"
+)
+
+// RestMethod is what a profile gives up in exchange for recovering walks.
+type RestMethod int
+
+const (
+	// RestWithTime recovers a single walk for free but can only be taken once every RestTimeCooldown.
+	RestWithTime RestMethod = iota
+	// RestWithCurrency recovers RestCurrencyRecovery walks for RestCurrencyCost currency.
+	RestWithCurrency
+	// RestWithFood consumes one food item from the backpack and recovers the walks defined by the item.
+	RestWithFood
+)
+
+const (
+	RestTimeRecovery     = 1
+	RestTimeCooldown     = 15 * time.Minute
+	RestCurrencyCost     = 10
+	RestCurrencyRecovery = 3
+)
+
+var (
+	ErrWellRested        = errors.New("you are already well rested")
+	ErrNotEnoughTokens   = errors.New("not enough tokens to rest")
+	ErrNotFood           = errors.New("item cannot be eaten")
+	ErrFoodNotInBackpack = errors.New("food is not in your backpack")
+	ErrRestCooldown      = errors.New("you rested too recently")
+)
+
+// Rest reduces the walk count of a profile in exchange for time, currency, or a food item. Events emitted include
+// a rest event. The profile is not modified if it has nothing to recover or cannot pay for the rest. The walk count
+// is expected to include walks recovered over time, which the walk limiter applies before a rest.
+func Rest(profile *components.Profile, method RestMethod, food components.ItemType, loader Loader) (*components.Profile, []components.Event, error) {
+	return RestCtx(context.Background(), profile, method, food, loader)
+}
+
+// RestCtx is Rest with a context that is passed to the loader.
+func RestCtx(
+	ctx context.Context,
+	profile *components.Profile,
+	method RestMethod,
+	food components.ItemType,
+	loader Loader,
+) (*components.Profile, []components.Event, error) {
+	if profile.Limits == nil || profile.Limits.WalkCount == 0 {
+		return profile, nil, ErrWellRested
+	}
+
+	var evt events.RestEvent
+
+	switch method {
+	case RestWithTime:
+		now := time.Now()
+
+		if wait := RestTimeCooldown - now.Sub(profile.Limits.LastRest); wait > 0 {
+			return profile, nil, fmt.Errorf("%w: try again in %s", ErrRestCooldown, wait.Round(time.Second))
+		}
+
+		profile.Limits.LastRest = now
+		evt = events.NewRestEvent(RestTimeRecovery)
+	case RestWithCurrency:
+		if profile.Currency < RestCurrencyCost {
+			return profile, nil, ErrNotEnoughTokens
+		}
+
+		profile.Currency -= RestCurrencyCost
+		evt = events.NewPaidRestEvent(RestCurrencyRecovery, RestCurrencyCost)
+	case RestWithFood:
+		var items []components.Item
+		if err := loader.LoadCtx(ctx, &items); err != nil {
+			return profile, nil, err
+		}
+
+		item, ok := itemByType(items, food)
+		if !ok || !item.IsFood() {
+			return profile, nil, ErrNotFood
+		}
+
+		backpack, err := profile.Backpack.Remove(food, 1)
+		if err != nil {
+			return profile, nil, fmt.Errorf("%w: %w", ErrFoodNotInBackpack, err)
+		}
+
+		profile.Backpack = backpack
+		evt = events.NewFoodRestEvent(uint(item.Rest), item)
+	default:
+		return profile, nil, fmt.Errorf("unknown rest method: %d", method)
+	}
+
+	profile.Limits.WalkCount -= min(profile.Limits.WalkCount, uint64(evt.Recovered))
+
+	return profile, []components.Event{evt}, nil
+}
+
+func restCommand(ctx context.Context, profile *components.Profile, loader Loader, conf CommandConfig) (*components.Profile, []components.Event, error) {
+	return RestCtx(ctx, profile, conf.Rest, conf.Food, loader)
+}
@@ -0,0 +1,100 @@
+package deadenz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	deadenz "github.com/ciphermountain/deadenz/pkg"
+	"github.com/ciphermountain/deadenz/pkg/components"
+	"github.com/ciphermountain/deadenz/pkg/events"
+)
+
+func TestRest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("profile without walks should not rest", func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := deadenz.Rest(&components.Profile{}, deadenz.RestWithTime, 0, nil)
+
+			require.ErrorIs(t, err, deadenz.ErrWellRested)
+		})
+
+		t.Run("resting for free again before the cooldown should not modify profile", func(t *testing.T) {
+			t.Parallel()
+
+			lastRest := time.Now().Add(-deadenz.RestTimeCooldown / 2)
+			profile := &components.Profile{Limits: &components.Limits{WalkCount: 5, LastRest: lastRest}}
+			newProfile, _, err := deadenz.Rest(profile, deadenz.RestWithTime, 0, nil)
+
+			require.ErrorIs(t, err, deadenz.ErrRestCooldown)
+			assert.Equal(t, uint64(5), newProfile.Limits.WalkCount)
+			assert.Equal(t, lastRest, newProfile.Limits.LastRest)
+		})
+
+		t.Run("paying without enough tokens should not modify profile", func(t *testing.T) {
+			t.Parallel()
+
+			profile := &components.Profile{
+				Currency: deadenz.RestCurrencyCost - 1,
+				Limits:   &components.Limits{WalkCount: 5},
+			}
+			newProfile, _, err := deadenz.Rest(profile, deadenz.RestWithCurrency, 0, nil)
+
+			require.ErrorIs(t, err, deadenz.ErrNotEnoughTokens)
+			assert.Equal(t, uint64(5), newProfile.Limits.WalkCount)
+		})
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("waiting recovers a single walk", func(t *testing.T) {
+			t.Parallel()
+
+			profile := &components.Profile{Limits: &components.Limits{WalkCount: 5}}
+			newProfile, evts, err := deadenz.Rest(profile, deadenz.RestWithTime, 0, nil)
+
+			require.NoError(t, err)
+			require.Len(t, evts, 1)
+
+			assert.Equal(t, uint64(5-deadenz.RestTimeRecovery), newProfile.Limits.WalkCount)
+			assert.IsType(t, events.RestEvent{}, evts[0])
+		})
+
+		t.Run("resting for free is allowed again after the cooldown", func(t *testing.T) {
+			t.Parallel()
+
+			start := time.Now()
+			profile := &components.Profile{
+				Limits: &components.Limits{WalkCount: 5, LastRest: start.Add(-deadenz.RestTimeCooldown)},
+			}
+			newProfile, _, err := deadenz.Rest(profile, deadenz.RestWithTime, 0, nil)
+
+			require.NoError(t, err)
+			assert.Equal(t, uint64(4), newProfile.Limits.WalkCount)
+			assert.False(t, newProfile.Limits.LastRest.Before(start))
+		})
+
+		t.Run("paying spends tokens and never recovers below zero", func(t *testing.T) {
+			t.Parallel()
+
+			profile := &components.Profile{
+				Currency: deadenz.RestCurrencyCost,
+				Limits:   &components.Limits{WalkCount: 1},
+			}
+			newProfile, _, err := deadenz.Rest(profile, deadenz.RestWithCurrency, 0, nil)
+
+			require.NoError(t, err)
+
+			assert.Equal(t, uint(0), newProfile.Currency)
+			assert.Equal(t, uint64(0), newProfile.Limits.WalkCount)
+		})
+	})
+}
@@ -4,24 +4,47 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
+	deadenz "github.com/ciphermountain/deadenz/pkg"
 	"github.com/ciphermountain/deadenz/pkg/components"
+	"github.com/ciphermountain/deadenz/pkg/middleware"
 	proto "github.com/ciphermountain/deadenz/pkg/proto/core"
 )
 
 type Client struct {
 	conn       *grpc.ClientConn
 	grpcClient proto.DeadenzClient
+	creds      credentials.TransportCredentials
 
 	closer sync.Once
 }
 
-func NewClient(addr string) (*Client, error) {
+// ClientOpt configures optional behaviour of a core client.
+type ClientOpt func(*Client)
+
+// WithCredentials dials the core service with the provided credentials instead of an insecure connection.
+func WithCredentials(creds credentials.TransportCredentials) ClientOpt {
+	return func(c *Client) {
+		c.creds = creds
+	}
+}
+
+func NewClient(addr string, clientOpts ...ClientOpt) (*Client, error) {
+	client := &Client{
+		creds: insecure.NewCredentials(),
+	}
+
+	for _, opt := range clientOpts {
+		opt(client)
+	}
+
 	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(client.creds),
 	}
 
 	conn, err := grpc.Dial(addr, opts...)
@@ -29,32 +52,183 @@ func NewClient(addr string) (*Client, error) {
 		return nil, err
 	}
 
-	return &Client{
-		conn:       conn,
-		grpcClient: proto.NewDeadenzClient(conn),
-	}, nil
+	client.conn = conn
+	client.grpcClient = proto.NewDeadenzClient(conn)
+
+	return client, nil
 }
 
 func (c *Client) Spawnin(ctx context.Context, profile *components.Profile) ([]string, *components.Profile, error) {
-	req := &proto.RunRequest{
-		Command: &proto.RunRequest_Spawnin{
-			Spawnin: &proto.SpawninCommand{},
-		},
-		Profile: profileToProto(profile),
+	return c.Run(ctx, profile, deadenz.SpawninCommandType)
+}
+
+func (c *Client) Walk(ctx context.Context, profile *components.Profile) ([]string, *components.Profile, error) {
+	return c.Run(ctx, profile, deadenz.WalkCommandType)
+}
+
+// Summary is the aggregated outcome of a run that may include several steps.
+type Summary struct {
+	Events   []string
+	Steps    uint32
+	XP       uint64
+	Currency uint64
+	// Stopped is the reason a repeated run ended early and is nil when every step ran.
+	Stopped error
+	// Diff lists the changes made to the profile across every step.
+	Diff components.ProfileDiff
+	// Next is the command suggested by the service after the run.
+	Next deadenz.CommandType
+}
+
+// AutoWalk walks up to the provided number of steps in a single request. Zero steps walks until something stops
+// the character.
+func (c *Client) AutoWalk(ctx context.Context, profile *components.Profile, steps uint32) (Summary, *components.Profile, error) {
+	opt := deadenz.WithRepeat(uint(steps))
+	if steps == 0 {
+		opt = deadenz.WithRepeatUntilStopped()
 	}
 
-	return c.run(ctx, profile, req)
+	return c.Execute(ctx, profile, deadenz.WalkCommandType, opt)
 }
 
-func (c *Client) Walk(ctx context.Context, profile *components.Profile) ([]string, *components.Profile, error) {
+func (c *Client) Craft(ctx context.Context, profile *components.Profile, recipe components.RecipeType) ([]string, *components.Profile, error) {
+	return c.Run(ctx, profile, deadenz.CraftCommandType, deadenz.WithRecipe(recipe))
+}
+
+// Rest recovers walks using the provided rest method. The food item is only used when resting with food.
+func (c *Client) Rest(
+	ctx context.Context,
+	profile *components.Profile,
+	method deadenz.RestMethod,
+	food components.ItemType,
+) ([]string, *components.Profile, error) {
+	var opts []deadenz.CommandOpt
+
+	switch method {
+	case deadenz.RestWithCurrency:
+		opts = append(opts, deadenz.WithRestCurrency())
+	case deadenz.RestWithFood:
+		opts = append(opts, deadenz.WithRestFood(food))
+	}
+
+	return c.Run(ctx, profile, deadenz.RestCommandType, opts...)
+}
+
+// Run runs any registered command by name with the arguments encoded by the command.
+func (c *Client) Run(
+	ctx context.Context,
+	profile *components.Profile,
+	command deadenz.CommandType,
+	opts ...deadenz.CommandOpt,
+) ([]string, *components.Profile, error) {
+	summary, updated, err := c.Execute(ctx, profile, command, opts...)
+
+	return summary.Events, updated, err
+}
+
+// Execute runs any registered command by name and returns the summary of the run with the command suggested next.
+func (c *Client) Execute(
+	ctx context.Context,
+	profile *components.Profile,
+	command deadenz.CommandType,
+	opts ...deadenz.CommandOpt,
+) (Summary, *components.Profile, error) {
+	args, err := deadenz.EncodeCommand(command, opts...)
+	if err != nil {
+		return Summary{}, profile, err
+	}
+
 	req := &proto.RunRequest{
-		Command: &proto.RunRequest_Walk{
-			Walk: &proto.WalkCommand{},
+		Command: &proto.RunRequest_Named{
+			Named: &proto.NamedCommand{
+				Name: command.String(),
+				Args: args,
+			},
 		},
 		Profile: profileToProto(profile),
 	}
 
-	return c.run(ctx, profile, req)
+	resp, err := c.grpcClient.Run(ctx, req)
+	if err != nil {
+		return Summary{}, profile, err
+	}
+
+	if resp.Response.Status != proto.Status_OK {
+		return Summary{}, profile, protoToError(resp.Response.GetMessage(), resp.Response.GetError())
+	}
+
+	protoProfile := protoToProfile(resp.Profile)
+	summary := Summary{
+		Events:   resp.Events,
+		Steps:    resp.GetSummary().GetSteps(),
+		XP:       resp.GetSummary().GetXp(),
+		Currency: resp.GetSummary().GetCurrency(),
+		Diff:     protoToDiff(resp.GetDiff()),
+		Next:     deadenz.DefaultNextCommand(&protoProfile),
+	}
+
+	// services may suggest commands the client does not know
+	if next, err := deadenz.ParseCommandType(resp.GetNext()); err == nil {
+		summary.Next = next
+	}
+
+	if stopped := resp.GetSummary().GetStopped(); stopped != "" {
+		summary.Stopped = protoToError(stopped, resp.GetSummary().GetStoppedError())
+	}
+
+	return summary, &protoProfile, nil
+}
+
+// Status is the server computed state of a profile.
+type Status struct {
+	ActiveItem *components.Item
+	// Walk is nil when the server does not limit walking.
+	Walk *middleware.WalkStatus
+	// Commands lists the capacity of every rate limited command.
+	Commands []middleware.CommandStatus
+}
+
+func (c *Client) Status(ctx context.Context, profile *components.Profile) (Status, error) {
+	resp, err := c.grpcClient.Status(ctx, &proto.StatusRequest{Profile: profileToProto(profile)})
+	if err != nil {
+		return Status{}, err
+	}
+
+	if resp.Response.Status != proto.Status_OK {
+		return Status{}, unsuccessful(resp.Response)
+	}
+
+	var status Status
+
+	if resp.ActiveItem != nil {
+		item := protoToItem(resp.ActiveItem)
+		status.ActiveItem = &item
+	}
+
+	if resp.Walk != nil {
+		status.Walk = &middleware.WalkStatus{
+			Limit:     resp.Walk.GetLimit(),
+			Remaining: resp.Walk.GetRemaining(),
+			NextWalk:  time.UnixMilli(resp.Walk.GetNextWalk()),
+		}
+	}
+
+	for _, command := range resp.GetCommands() {
+		// commands that are not registered with the client cannot be used by it
+		cmdType, err := deadenz.ParseCommandType(command.GetCommand())
+		if err != nil {
+			continue
+		}
+
+		status.Commands = append(status.Commands, middleware.CommandStatus{
+			Command:   cmdType,
+			Capacity:  command.GetCapacity(),
+			Remaining: command.GetRemaining(),
+			NextUse:   time.UnixMilli(command.GetNextUse()),
+		})
+	}
+
+	return status, nil
 }
 
 func (c *Client) Items(ctx context.Context) ([]components.Item, error) {
@@ -68,7 +242,7 @@ func (c *Client) Items(ctx context.Context) ([]components.Item, error) {
 	}
 
 	if resp.Response.Status != proto.Status_OK {
-		return nil, fmt.Errorf("service returned an unsuccessful response: %s", resp.Response.Message)
+		return nil, unsuccessful(resp.Response)
 	}
 
 	switch asset := resp.Asset.(type) {
@@ -90,7 +264,7 @@ func (c *Client) Characters(ctx context.Context) ([]components.Character, error)
 	}
 
 	if resp.Response.Status != proto.Status_OK {
-		return nil, fmt.Errorf("service returned an unsuccessful response: %s", resp.Response.Message)
+		return nil, unsuccessful(resp.Response)
 	}
 
 	switch asset := resp.Asset.(type) {
@@ -101,31 +275,34 @@ func (c *Client) Characters(ctx context.Context) ([]components.Character, error)
 	}
 }
 
-func (c *Client) Close() error {
-	var err error
-
-	c.closer.Do(func() {
-		err = c.conn.Close()
-	})
-
-	return err
-}
+func (c *Client) Recipes(ctx context.Context) ([]components.Recipe, error) {
+	req := &proto.AssetRequest{
+		Type: proto.AssetType_RecipeAsset,
+	}
 
-func (c *Client) run(
-	ctx context.Context,
-	profile *components.Profile,
-	req *proto.RunRequest,
-) ([]string, *components.Profile, error) {
-	resp, err := c.grpcClient.Run(ctx, req)
+	resp, err := c.grpcClient.Assets(ctx, req)
 	if err != nil {
-		return nil, profile, err
+		return nil, err
 	}
 
 	if resp.Response.Status != proto.Status_OK {
-		return nil, profile, fmt.Errorf("%s", resp.Response.Message)
+		return nil, unsuccessful(resp.Response)
 	}
 
-	protoProfile := protoToProfile(resp.Profile)
+	switch asset := resp.Asset.(type) {
+	case *proto.AssetResponse_Recipe:
+		return mutateListValues(asset.Recipe.Recipes, protoToRecipe), nil
+	default:
+		return nil, fmt.Errorf("unexpected response")
+	}
+}
 
-	return resp.Events, &protoProfile, nil
+func (c *Client) Close() error {
+	var err error
+
+	c.closer.Do(func() {
+		err = c.conn.Close()
+	})
+
+	return err
 }
@@ -0,0 +1,130 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ciphermountain/deadenz/internal/util"
+	deadenz "github.com/ciphermountain/deadenz/pkg"
+	"github.com/ciphermountain/deadenz/pkg/middleware"
+	proto "github.com/ciphermountain/deadenz/pkg/proto/core"
+)
+
+var ErrAssetUnavailable = errors.New("game assets are unavailable")
+
+// errorCodes maps error codes to the errors they are created from. Errors are matched in order so wrapping errors
+// must come before the errors they wrap.
+var errorCodes = []struct {
+	code proto.ErrorCode
+	err  error
+}{
+	{code: proto.ErrorCode_NotSpawnedIn, err: deadenz.ErrNotSpawnedIn},
+	{code: proto.ErrorCode_AlreadySpawnedIn, err: deadenz.ErrAlreadySpawnedIn},
+	{code: proto.ErrorCode_WalkTooMuch, err: middleware.ErrWalkTooMuch},
+	{code: proto.ErrorCode_RateLimited, err: middleware.ErrRateLimited},
+	{code: proto.ErrorCode_BurstExceeded, err: middleware.ErrBurstExceeded},
+	{code: proto.ErrorCode_NilProfile, err: middleware.ErrNilProfile},
+	{code: proto.ErrorCode_UnrecognizedCommand, err: deadenz.ErrUnrecognizedCommand},
+	{code: proto.ErrorCode_NotRepeatable, err: deadenz.ErrNotRepeatable},
+	{code: proto.ErrorCode_CharacterDied, err: deadenz.ErrCharacterDied},
+	{code: proto.ErrorCode_BackpackTooSmall, err: deadenz.ErrBackpackTooSmall},
+	{code: proto.ErrorCode_UnknownRecipe, err: deadenz.ErrUnknownRecipe},
+	{code: proto.ErrorCode_MissingIngredients, err: deadenz.ErrMissingIngredients},
+	{code: proto.ErrorCode_RecipeRequirements, err: deadenz.ErrRecipeRequirements},
+	{code: proto.ErrorCode_WellRested, err: deadenz.ErrWellRested},
+	{code: proto.ErrorCode_NotEnoughTokens, err: deadenz.ErrNotEnoughTokens},
+	{code: proto.ErrorCode_NotFood, err: deadenz.ErrNotFood},
+	{code: proto.ErrorCode_FoodNotInBackpack, err: deadenz.ErrFoodNotInBackpack},
+	{code: proto.ErrorCode_DeathNotApplicable, err: deadenz.ErrDeathNotApplicable},
+	{code: proto.ErrorCode_RestCooldown, err: deadenz.ErrRestCooldown},
+	{code: proto.ErrorCode_AssetUnavailable, err: ErrAssetUnavailable},
+	{code: proto.ErrorCode_AssetUnavailable, err: util.ErrMissingLoader},
+	{code: proto.ErrorCode_AssetUnavailable, err: util.ErrMissingParser},
+	{code: proto.ErrorCode_AssetUnavailable, err: util.ErrLoadFailed},
+}
+
+// responseError is an error returned by the core service. The message is the one created by the service and the
+// wrapped error is the known error identified by the error code.
+type responseError struct {
+	message string
+	err     error
+}
+
+func (e *responseError) Error() string {
+	return e.message
+}
+
+func (e *responseError) Unwrap() error {
+	return e.err
+}
+
+// failure creates a failed response with the error code and details of the error.
+func failure(err error) *proto.Response {
+	return &proto.Response{
+		Status:  proto.Status_Failure,
+		Message: err.Error(),
+		Error:   errorToProto(err),
+	}
+}
+
+func errorToProto(err error) *proto.ErrorDetails {
+	details := &proto.ErrorDetails{
+		Code: proto.ErrorCode_UnknownError,
+	}
+
+	for _, known := range errorCodes {
+		if errors.Is(err, known.err) {
+			details.Code = known.code
+
+			break
+		}
+	}
+
+	var limited *middleware.RateLimitError
+	if errors.As(err, &limited) {
+		details.RetryAfter = limited.RetryAfter.Milliseconds()
+		details.Command = limited.Command.String()
+	}
+
+	return details
+}
+
+// unsuccessful returns the error of a failed response to a request that is not a command.
+func unsuccessful(resp *proto.Response) error {
+	return fmt.Errorf("service returned an unsuccessful response: %w", protoToError(resp.GetMessage(), resp.GetError()))
+}
+
+// protoToError creates an error with the message of the service that can be matched with errors.Is against the
+// known error of the error code. Limited commands are returned as a middleware.RateLimitError.
+func protoToError(message string, details *proto.ErrorDetails) error {
+	var known error
+
+	for _, code := range errorCodes {
+		if code.code == details.GetCode() {
+			known = code.err
+
+			break
+		}
+	}
+
+	if known == nil {
+		return errors.New(message)
+	}
+
+	if details.GetRetryAfter() > 0 || details.GetCommand() != "" {
+		// a command the client does not know, such as one registered only on the server, is unknown
+		command, _ := deadenz.ParseCommandType(details.GetCommand())
+
+		return &middleware.RateLimitError{
+			Command:    command,
+			RetryAfter: time.Duration(details.GetRetryAfter()) * time.Millisecond,
+			Err:        known,
+		}
+	}
+
+	return &responseError{
+		message: message,
+		err:     known,
+	}
+}
@@ -0,0 +1,117 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ciphermountain/deadenz/internal/util"
+	deadenz "github.com/ciphermountain/deadenz/pkg"
+	"github.com/ciphermountain/deadenz/pkg/middleware"
+	proto "github.com/ciphermountain/deadenz/pkg/proto/core"
+)
+
+func TestErrorCodes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("known errors are returned as the same error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, known := range errorCodes {
+			resp := failure(fmt.Errorf("wrapped: %w", known.err))
+			err := protoToError(resp.GetMessage(), resp.GetError())
+
+			assert.Equal(t, known.code, resp.GetError().GetCode())
+			assert.Equal(t, resp.GetMessage(), err.Error())
+			assert.ErrorIs(t, err, errorCodeError(known.code))
+		}
+	})
+
+	t.Run("loader errors are asset unavailable errors", func(t *testing.T) {
+		t.Parallel()
+
+		resp := failure(fmt.Errorf("%w for items", util.ErrMissingLoader))
+
+		assert.Equal(t, proto.ErrorCode_AssetUnavailable, resp.GetError().GetCode())
+		assert.ErrorIs(t, protoToError(resp.GetMessage(), resp.GetError()), ErrAssetUnavailable)
+	})
+
+	t.Run("rate limits carry the time to retry", func(t *testing.T) {
+		t.Parallel()
+
+		resp := failure(&middleware.RateLimitError{
+			Command:    deadenz.WalkCommandType,
+			RetryAfter: 90 * time.Second,
+			Err:        middleware.ErrWalkTooMuch,
+		})
+
+		assert.Equal(t, proto.ErrorCode_WalkTooMuch, resp.GetError().GetCode())
+		assert.Equal(t, int64(90000), resp.GetError().GetRetryAfter())
+
+		err := protoToError(resp.GetMessage(), resp.GetError())
+
+		var limited *middleware.RateLimitError
+
+		require.ErrorAs(t, err, &limited)
+		assert.ErrorIs(t, err, middleware.ErrWalkTooMuch)
+		assert.Equal(t, deadenz.WalkCommandType, limited.Command)
+		assert.Equal(t, 90*time.Second, limited.RetryAfter)
+		assert.Equal(t, resp.GetMessage(), err.Error())
+	})
+
+	t.Run("rate limits of unregistered commands are unknown commands", func(t *testing.T) {
+		t.Parallel()
+
+		resp := failure(&middleware.RateLimitError{
+			Command:    deadenz.WalkCommandType,
+			RetryAfter: time.Second,
+			Err:        middleware.ErrRateLimited,
+		})
+		resp.Error.Command = "test_server_only"
+
+		var limited *middleware.RateLimitError
+
+		require.ErrorAs(t, protoToError(resp.GetMessage(), resp.GetError()), &limited)
+		assert.Equal(t, deadenz.UnknownCommandType, limited.Command)
+	})
+
+	t.Run("walk limits that stop a repeated walk are walk limits for the client", func(t *testing.T) {
+		t.Parallel()
+
+		// the client rests when a walk is stopped by the walk limiter
+		stopped := &middleware.RateLimitError{
+			Command:    deadenz.WalkCommandType,
+			RetryAfter: time.Minute,
+			Err:        middleware.ErrWalkTooMuch,
+		}
+
+		err := protoToError(stopped.Error(), errorToProto(stopped))
+
+		assert.ErrorIs(t, err, middleware.ErrWalkTooMuch)
+		assert.Equal(t, stopped.Error(), err.Error())
+	})
+
+	t.Run("unknown errors keep the message", func(t *testing.T) {
+		t.Parallel()
+
+		resp := failure(errors.New("something broke"))
+
+		assert.Equal(t, proto.ErrorCode_UnknownError, resp.GetError().GetCode())
+		assert.EqualError(t, protoToError(resp.GetMessage(), resp.GetError()), "something broke")
+	})
+}
+
+// errorCodeError returns the first error registered for the code which is the error clients receive.
+func errorCodeError(code proto.ErrorCode) error {
+	for _, known := range errorCodes {
+		if known.code == code {
+			return known.err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/ciphermountain/deadenz/pkg/components"
+	"github.com/ciphermountain/deadenz/pkg/middleware"
+)
+
+// RegistrationRetryInterval is how often failed multiverse registrations are retried.
+const RegistrationRetryInterval = 10 * time.Second
+
+// RegistrationTimeout bounds every request to the multiverse so that an unresponsive multiverse cannot hold up
+// commands or retries.
+const RegistrationTimeout = 5 * time.Second
+
+var ErrRegistrarClosed = errors.New("multiverse registrar closed")
+
+var _ middleware.Registrar = &Registrar{}
+
+// MultiverseRegistrar is the part of the multiverse client that registers players.
+type MultiverseRegistrar interface {
+	Register(ctx context.Context, uid string, character components.CharacterType) error
+	Deregister(ctx context.Context, uid string) error
+}
+
+// Registrar registers players with the multiverse. A registration that is not acknowledged is reported and kept
+// to be retried in the background so that an unavailable multiverse does not block spawning. Only the latest
+// registration for each player is retried and no lock is held while waiting on the multiverse.
+type Registrar struct {
+	client   MultiverseRegistrar
+	interval time.Duration
+	timeout  time.Duration
+
+	mu      sync.Mutex
+	seq     uint64
+	pending map[string]pendingRegistration
+	// latest is the sequence of the newest registration sent for each player that is not yet acknowledged. A
+	// registration with an older sequence was superseded and its outcome is ignored.
+	latest map[string]uint64
+
+	// ctx is canceled on close to end retries waiting on the multiverse
+	ctx    context.Context
+	cancel context.CancelFunc
+	stop   chan struct{}
+	done   chan struct{}
+	closer sync.Once
+}
+
+// pendingRegistration is a registration that has not been acknowledged. A nil character is a deregistration.
+type pendingRegistration struct {
+	character *components.CharacterType
+	attempts  int
+	seq       uint64
+}
+
+// NewRegistrar creates a registrar that retries failed registrations on the provided interval until closed. Each
+// request to the multiverse fails after the provided timeout.
+func NewRegistrar(client MultiverseRegistrar, interval, timeout time.Duration) *Registrar {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	registrar := &Registrar{
+		client:   client,
+		interval: interval,
+		timeout:  timeout,
+		pending:  make(map[string]pendingRegistration),
+		latest:   make(map[string]uint64),
+		ctx:      ctx,
+		cancel:   cancel,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+
+	go registrar.run()
+
+	return registrar
+}
+
+// Register records the character the player is playing. An error is only returned when the registrar is closed.
+func (r *Registrar) Register(ctx context.Context, uid string, character components.CharacterType) error {
+	return r.send(ctx, uid, &character)
+}
+
+// Deregister removes the player from the multiverse. An error is only returned when the registrar is closed.
+func (r *Registrar) Deregister(ctx context.Context, uid string) error {
+	return r.send(ctx, uid, nil)
+}
+
+// Pending returns the number of players with a registration waiting to be retried.
+func (r *Registrar) Pending() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.pending)
+}
+
+// Close stops retrying. Registrations that are still pending are reported and dropped.
+func (r *Registrar) Close() error {
+	r.closer.Do(func() {
+		close(r.stop)
+		r.cancel()
+		<-r.done
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		for uid := range r.pending {
+			log.Printf("dropping unacknowledged multiverse registration for %s", uid)
+		}
+	})
+
+	return nil
+}
+
+func (r *Registrar) send(ctx context.Context, uid string, character *components.CharacterType) error {
+	select {
+	case <-r.stop:
+		return ErrRegistrarClosed
+	default:
+	}
+
+	r.mu.Lock()
+	r.seq++
+	seq := r.seq
+	r.latest[uid] = seq
+	r.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.apply(ctx, uid, character)
+	if err != nil {
+		log.Printf("multiverse registration for %s failed and will be retried: %s", uid, err.Error())
+	}
+
+	r.complete(uid, pendingRegistration{character: character, attempts: 1, seq: seq}, err)
+
+	return nil
+}
+
+func (r *Registrar) apply(ctx context.Context, uid string, character *components.CharacterType) error {
+	if character == nil {
+		return r.client.Deregister(ctx, uid)
+	}
+
+	return r.client.Register(ctx, uid, *character)
+}
+
+// complete records the outcome of a registration. The outcome is ignored when a newer registration for the same
+// player was sent in the meantime.
+func (r *Registrar) complete(uid string, reg pendingRegistration, err error) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.latest[uid] != reg.seq {
+		return false
+	}
+
+	if err != nil {
+		r.pending[uid] = reg
+
+		return true
+	}
+
+	delete(r.pending, uid)
+	delete(r.latest, uid)
+
+	return true
+}
+
+// superseded reports whether a newer registration than the provided one was sent for the player.
+func (r *Registrar) superseded(uid string, reg pendingRegistration) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.latest[uid] != reg.seq
+}
+
+func (r *Registrar) run() {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			r.retry()
+		}
+	}
+}
+
+func (r *Registrar) retry() {
+	r.mu.Lock()
+	pending := make(map[string]pendingRegistration, len(r.pending))
+	for uid, reg := range r.pending {
+		pending[uid] = reg
+	}
+	r.mu.Unlock()
+
+	for uid, reg := range pending {
+		select {
+		case <-r.stop:
+			return
+		default:
+		}
+
+		if r.superseded(uid, reg) {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(r.ctx, r.timeout)
+		err := r.apply(ctx, uid, reg.character)
+
+		cancel()
+
+		if err != nil {
+			reg.attempts++
+		}
+
+		if !r.complete(uid, reg, err) {
+			continue
+		}
+
+		if err != nil {
+			log.Printf("multiverse registration for %s failed after %d attempts: %s", uid, reg.attempts, err.Error())
+		} else {
+			log.Printf("multiverse registration for %s acknowledged after %d attempts", uid, reg.attempts+1)
+		}
+	}
+}
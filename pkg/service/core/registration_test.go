@@ -0,0 +1,160 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ciphermountain/deadenz/pkg/components"
+	"github.com/ciphermountain/deadenz/pkg/service/core"
+)
+
+func TestRegistrar(t *testing.T) {
+	t.Parallel()
+
+	errUnavailable := errors.New("unavailable")
+
+	t.Run("commands finish while the multiverse blocks", func(t *testing.T) {
+		t.Parallel()
+
+		client := &fakeMultiverse{
+			register: func(ctx context.Context, _ string, _ components.CharacterType) error {
+				<-ctx.Done()
+
+				return ctx.Err()
+			},
+		}
+
+		registrar := core.NewRegistrar(client, time.Hour, 10*time.Millisecond)
+
+		t.Cleanup(func() { registrar.Close() })
+
+		assertFinishes(t, func() {
+			require.NoError(t, registrar.Register(context.Background(), "player", 1))
+		})
+
+		assert.Equal(t, 1, registrar.Pending())
+	})
+
+	t.Run("commands finish while a retry blocks", func(t *testing.T) {
+		t.Parallel()
+
+		retrying := make(chan struct{})
+		client := &fakeMultiverse{
+			register: func(ctx context.Context, uid string, _ components.CharacterType) error {
+				if uid != "blocked" {
+					return nil
+				}
+
+				select {
+				case retrying <- struct{}{}:
+				default:
+					// the first attempt fails so that the registration is retried
+					return errUnavailable
+				}
+
+				<-ctx.Done()
+
+				return ctx.Err()
+			},
+		}
+
+		registrar := core.NewRegistrar(client, 10*time.Millisecond, time.Hour)
+
+		t.Cleanup(func() { registrar.Close() })
+
+		require.NoError(t, registrar.Register(context.Background(), "blocked", 1))
+
+		// wait for the retry to be waiting on the multiverse
+		<-retrying
+
+		assertFinishes(t, func() {
+			require.NoError(t, registrar.Register(context.Background(), "other", 1))
+		})
+	})
+
+	t.Run("superseded registrations are not retried", func(t *testing.T) {
+		t.Parallel()
+
+		live := make(chan struct{})
+		release := make(chan struct{})
+		stale := make(chan struct{}, 100)
+
+		client := &fakeMultiverse{
+			register: func(_ context.Context, _ string, character components.CharacterType) error {
+				if character == 2 {
+					close(live)
+					<-release
+
+					return nil
+				}
+
+				select {
+				case <-live:
+					stale <- struct{}{}
+				default:
+				}
+
+				return errUnavailable
+			},
+		}
+
+		registrar := core.NewRegistrar(client, 5*time.Millisecond, time.Hour)
+
+		t.Cleanup(func() { registrar.Close() })
+
+		require.NoError(t, registrar.Register(context.Background(), "player", 1))
+
+		finished := make(chan struct{})
+
+		go func() {
+			defer close(finished)
+
+			_ = registrar.Register(context.Background(), "player", 2)
+		}()
+
+		<-live
+
+		// give the retry loop time to run while the newer registration is in flight
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		<-finished
+
+		assert.Equal(t, 0, registrar.Pending())
+		assert.Empty(t, stale, "superseded registration was retried")
+	})
+}
+
+func assertFinishes(t *testing.T, f func()) {
+	t.Helper()
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		f()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("blocked on the multiverse")
+	}
+}
+
+type fakeMultiverse struct {
+	register func(context.Context, string, components.CharacterType) error
+}
+
+func (m *fakeMultiverse) Register(ctx context.Context, uid string, character components.CharacterType) error {
+	return m.register(ctx, uid, character)
+}
+
+func (m *fakeMultiverse) Deregister(_ context.Context, _ string) error {
+	return nil
+}
@@ -3,8 +3,11 @@ package core
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
 	"reflect"
+	"slices"
 	"strconv"
 	"time"
 
@@ -22,57 +25,127 @@ var _ proto.DeadenzServer = &Server{}
 
 type Server struct {
 	proto.UnimplementedDeadenzServer
-	loader       *util.DataLoader
-	preCommands  []deadenz.PreRunFunc
-	postCommands []deadenz.PostRunFunc
+	loader      *util.DataLoader
+	items       *util.ItemProvider
+	middleware  []deadenz.Middleware
+	walkLimit   uint16
+	walkLimited bool
+	// commandLimits are the configured command rate limits reported by Status
+	commandLimits []middleware.CommandRateLimit
+	registrar     *Registrar
+	publisher     *multiverse.Publisher
+	// publisherConf is only used to create the publisher
+	publisherConf multiverse.PublisherConfig
 }
 
-func NewServer(client *multiverse.Client) *Server {
+// ServerOpt configures optional behaviour of a core server.
+type ServerOpt func(*Server)
+
+// WithPublisherConfig configures the outbox, batching and retries used to publish events to the multiverse.
+func WithPublisherConfig(conf multiverse.PublisherConfig) ServerOpt {
+	return func(s *Server) {
+		s.publisherConf = conf
+	}
+}
+
+// NewServer creates a core game server with the middleware chains built from the provided configuration.
+func NewServer(client *multiverse.Client, chain middleware.ChainConfig, opts ...ServerOpt) (*Server, error) {
 	loader := util.NewDataLoader()
 	items := util.NewItemProviderFromLoader(loader)
+	deps := middleware.Dependencies{
+		Items:      items,
+		Multiverse: client,
+	}
 
-	return &Server{
+	server := &Server{
 		loader: loader,
-		preCommands: []deadenz.PreRunFunc{
-			middleware.WalkLimiter(12, items),
-			middleware.WalkStatBuilder(2, items), // TODO: stats builder needs to be configured to items that can mutate stats
-		},
-		postCommands: []deadenz.PostRunFunc{
-			middleware.PublishEventsToMultiverse(client),
-			middleware.DeathActiveItemMiddleware(1, items), // TODO: death recovery active item needs to be configurable
-			middleware.WalkDeathEventMiddleware(),
-		},
+		items:  items,
 	}
+
+	for _, opt := range opts {
+		opt(server)
+	}
+
+	if client != nil {
+		publisher, err := multiverse.NewPublisher(client, server.publisherConf)
+		if err != nil {
+			return nil, err
+		}
+
+		server.publisher = publisher
+		server.registrar = NewRegistrar(client, RegistrationRetryInterval, RegistrationTimeout)
+
+		deps.Publisher = server.publisher
+		deps.Registrar = server.registrar
+	}
+
+	chained, err := middleware.Build(chain, deps)
+	if err != nil {
+		server.Close()
+
+		return nil, err
+	}
+
+	server.middleware = chained
+	server.walkLimit, server.walkLimited = middleware.WalkLimitFromConfig(chain)
+	server.commandLimits = middleware.CommandLimitsFromConfig(chain)
+
+	return server, nil
 }
 
-func (s *Server) Run(ctx context.Context, req *proto.RunRequest) (*proto.RunResponse, error) {
-	var command deadenz.CommandType
+// Close stops background work such as retrying multiverse registrations and publishing events.
+func (s *Server) Close() error {
+	var err error
 
-	switch req.Command.(type) {
-	case *proto.RunRequest_Walk:
-		command = deadenz.WalkCommandType
-	case *proto.RunRequest_Spawnin:
-		command = deadenz.SpawninCommandType
-	default:
-		return &proto.RunResponse{
-			Response: &proto.Response{
-				Status:  proto.Status_Failure,
-				Message: "unrecognized command",
-			},
-			Profile: req.GetProfile(),
-		}, nil
+	if s.registrar != nil {
+		err = s.registrar.Close()
+	}
+
+	if s.publisher != nil {
+		err = errors.Join(err, s.publisher.Close())
+
+		metrics := s.publisher.Metrics()
+
+		log.Printf(
+			"multiverse events published: %d, rejected: %d, dropped: %d, delayed: %d, pending: %d, max delay: %s",
+			metrics.Published, metrics.Rejected, metrics.Dropped, metrics.Delayed, metrics.Pending, metrics.MaxDelay,
+		)
+	}
+
+	return err
+}
+
+// Ready returns an error unless every asset type has a loader configured whose data can be loaded and parsed.
+func (s *Server) Ready(ctx context.Context) error {
+	values := make([]int32, 0, len(proto.AssetType_name))
+	for value := range proto.AssetType_name {
+		values = append(values, value)
+	}
+
+	slices.Sort(values)
+
+	keys := make([]reflect.Type, 0, len(values))
+
+	for _, value := range values {
+		key, _, ok := assetParser(proto.AssetType(value))
+		if !ok {
+			return fmt.Errorf("unrecognized asset type %s", proto.AssetType(value))
+		}
+
+		keys = append(keys, key)
 	}
 
-	profile := protoToProfile(req.GetProfile())
+	return s.loader.Check(ctx, keys...)
+}
 
-	result, err := deadenz.RunActionCommand(command, &profile, s.loader, s.preCommands, s.postCommands)
+// Run runs a registered command on the requested profile. Typed commands resolve to the named command they
+// encode as so that every command is decoded by the command registry.
+func (s *Server) Run(ctx context.Context, req *proto.RunRequest) (*proto.RunResponse, error) {
+	result, err := s.run(ctx, req)
 	if err != nil {
 		return &proto.RunResponse{
-			Response: &proto.Response{
-				Status:  proto.Status_Failure,
-				Message: err.Error(),
-			},
-			Profile: req.GetProfile(),
+			Response: failure(err),
+			Profile:  req.GetProfile(),
 		}, nil
 	}
 
@@ -82,38 +155,113 @@ func (s *Server) Run(ctx context.Context, req *proto.RunRequest) (*proto.RunResp
 		},
 		Profile: profileToProto(result.Profile),
 		Events:  eventsToSlice(result.Events),
+		Summary: runSummary(result),
+		Diff:    diffToProto(result.Diff),
+		Next:    result.DefaultCmd.String(),
 	}, nil
 }
 
-func (s *Server) Load(_ context.Context, req *proto.LoadRequest) (*proto.Response, error) {
+// run resolves the request to a registered command and runs it on the requested profile.
+func (s *Server) run(ctx context.Context, req *proto.RunRequest) (deadenz.Result, error) {
+	if req.GetProfile() == nil {
+		return deadenz.Result{}, middleware.ErrNilProfile
+	}
+
+	named, err := namedCommand(req)
+	if err != nil {
+		return deadenz.Result{}, err
+	}
+
+	command, err := deadenz.ParseCommandType(named.GetName())
+	if err != nil {
+		return deadenz.Result{}, err
+	}
+
+	opts, err := deadenz.DecodeCommand(command, named.GetArgs())
+	if err != nil {
+		return deadenz.Result{}, err
+	}
+
+	profile, err := s.loadProfile(req.GetProfile())
+	if err != nil {
+		return deadenz.Result{}, err
+	}
+
+	return deadenz.RunWithMiddleware(ctx, command, &profile, s.loader, s.middleware, opts...)
+}
+
+// namedCommand returns the named command of a run request. Typed commands are encoded by the command registry.
+func namedCommand(req *proto.RunRequest) (*proto.NamedCommand, error) {
 	var (
-		key    reflect.Type
-		parser util.Parser
+		command deadenz.CommandType
+		opts    []deadenz.CommandOpt
 	)
 
-	switch req.GetType() {
-	case proto.AssetType_ItemAsset:
-		key = itemType
-		parser = decodeItems
-	case proto.AssetType_CharacterAsset:
-		key = characterType
-		parser = decodeCharacters
-	case proto.AssetType_ItemDecisionAsset:
-		key = decType
-		parser = json.Unmarshal
-	case proto.AssetType_ActionAsset:
-		key = actionType
-		parser = json.Unmarshal
-	case proto.AssetType_EncounterAsset:
-		key = encType
-		parser = json.Unmarshal
-	case proto.AssetType_LiveMutationAsset:
-		key = liveType
-		parser = json.Unmarshal
-	case proto.AssetType_DieMutationAsset:
-		key = dieType
-		parser = json.Unmarshal
+	switch cmd := req.GetCommand().(type) {
+	case *proto.RunRequest_Named:
+		return cmd.Named, nil
+	case *proto.RunRequest_Spawnin:
+		command = deadenz.SpawninCommandType
+	case *proto.RunRequest_Walk:
+		command = deadenz.WalkCommandType
+
+		switch {
+		case cmd.Walk.GetUntil():
+			opts = append(opts, deadenz.WithRepeatUntilStopped())
+		case cmd.Walk.GetSteps() > 1:
+			opts = append(opts, deadenz.WithRepeat(uint(cmd.Walk.GetSteps())))
+		}
+	case *proto.RunRequest_Rest:
+		command = deadenz.RestCommandType
+
+		switch payment := cmd.Rest.GetPayment().(type) {
+		case *proto.RestCommand_Currency:
+			if payment.Currency {
+				opts = append(opts, deadenz.WithRestCurrency())
+			}
+		case *proto.RestCommand_Food:
+			opts = append(opts, deadenz.WithRestFood(components.ItemType(payment.Food)))
+		}
+	case *proto.RunRequest_Craft:
+		command = deadenz.CraftCommandType
+		opts = append(opts, deadenz.WithRecipe(components.RecipeType(cmd.Craft.GetRecipe())))
 	default:
+		return nil, deadenz.ErrUnrecognizedCommand
+	}
+
+	args, err := deadenz.EncodeCommand(command, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.NamedCommand{Name: command.String(), Args: args}, nil
+}
+
+// runSummary reports the earnings of a run.
+func runSummary(result deadenz.Result) *proto.RunSummary {
+	summary := &proto.RunSummary{
+		Steps: uint32(result.Steps),
+	}
+
+	if result.Diff.XP > 0 {
+		summary.Xp = uint64(result.Diff.XP)
+	}
+
+	if result.Diff.Currency > 0 {
+		summary.Currency = uint64(result.Diff.Currency)
+	}
+
+	if result.Stopped != nil {
+		summary.Stopped = result.Stopped.Error()
+		summary.StoppedError = errorToProto(result.Stopped)
+	}
+
+	return summary
+}
+
+func (s *Server) Load(_ context.Context, req *proto.LoadRequest) (*proto.Response, error) {
+	key, parser, ok := assetParser(req.GetType())
+	if !ok {
 		return &proto.Response{
 			Status:  proto.Status_Failure,
 			Message: "unrecognized asset type",
@@ -122,17 +270,11 @@ func (s *Server) Load(_ context.Context, req *proto.LoadRequest) (*proto.Respons
 
 	loader, err := getLoaderType(req)
 	if err != nil {
-		return &proto.Response{
-			Status:  proto.Status_Failure,
-			Message: err.Error(),
-		}, nil
+		return failure(err), nil
 	}
 
 	if err := s.loader.SetLoader(key, loader, parser); err != nil {
-		return &proto.Response{
-			Status:  proto.Status_Failure,
-			Message: err.Error(),
-		}, nil
+		return failure(err), nil
 	}
 
 	return &proto.Response{Status: proto.Status_OK}, nil
@@ -145,10 +287,7 @@ func (s *Server) Assets(ctx context.Context, req *proto.AssetRequest) (*proto.As
 
 		if err := s.loader.LoadCtx(ctx, &items); err != nil {
 			resp := &proto.AssetResponse{
-				Response: &proto.Response{
-					Status:  proto.Status_Failure,
-					Message: err.Error(),
-				},
+				Response: failure(err),
 			}
 
 			return resp, nil
@@ -171,10 +310,7 @@ func (s *Server) Assets(ctx context.Context, req *proto.AssetRequest) (*proto.As
 
 		if err := s.loader.LoadCtx(ctx, &characters); err != nil {
 			resp := &proto.AssetResponse{
-				Response: &proto.Response{
-					Status:  proto.Status_Failure,
-					Message: err.Error(),
-				},
+				Response: failure(err),
 			}
 
 			return resp, nil
@@ -191,6 +327,29 @@ func (s *Server) Assets(ctx context.Context, req *proto.AssetRequest) (*proto.As
 			},
 		}
 
+		return resp, nil
+	case proto.AssetType_RecipeAsset:
+		var recipes []components.Recipe
+
+		if err := s.loader.LoadCtx(ctx, &recipes); err != nil {
+			resp := &proto.AssetResponse{
+				Response: failure(err),
+			}
+
+			return resp, nil
+		}
+
+		resp := &proto.AssetResponse{
+			Response: &proto.Response{
+				Status: proto.Status_OK,
+			},
+			Asset: &proto.AssetResponse_Recipe{
+				Recipe: &proto.RecipeAssetResponse{
+					Recipes: mutateListValues(recipes, recipeToProto),
+				},
+			},
+		}
+
 		return resp, nil
 	default:
 		resp := &proto.AssetResponse{
@@ -204,6 +363,65 @@ func (s *Server) Assets(ctx context.Context, req *proto.AssetRequest) (*proto.As
 	}
 }
 
+// Status reports the active item, the walking capacity and the capacity of rate limited commands of a profile. Walk
+// status is only included when the server is configured with a walk limiter.
+func (s *Server) Status(_ context.Context, req *proto.StatusRequest) (*proto.StatusResponse, error) {
+	if req.GetProfile() == nil {
+		return &proto.StatusResponse{
+			Response: failure(middleware.ErrNilProfile),
+		}, nil
+	}
+
+	profile, err := s.loadProfile(req.GetProfile())
+	if err != nil {
+		return &proto.StatusResponse{
+			Response: failure(err),
+		}, nil
+	}
+
+	resp := &proto.StatusResponse{
+		Response: &proto.Response{
+			Status: proto.Status_OK,
+		},
+	}
+
+	if profile.ActiveItem != nil {
+		item, err := s.items.Item(*profile.ActiveItem)
+		if err != nil {
+			return &proto.StatusResponse{
+				Response: failure(err),
+			}, nil
+		}
+
+		resp.ActiveItem = itemToProto(*item)
+	}
+
+	now := time.Now()
+
+	if s.walkLimited {
+		status := middleware.WalkCapacity(s.walkLimit, s.items, &profile, now)
+
+		resp.Walk = &proto.WalkStatus{
+			Limit:     status.Limit,
+			Remaining: status.Remaining,
+			NextWalk:  status.NextWalk.UnixMilli(),
+		}
+	}
+
+	for _, limit := range s.commandLimits {
+		status := middleware.CommandCapacity(limit.Command, limit.Limit, &profile, now)
+
+		resp.Commands = append(resp.Commands, &proto.CommandStatus{
+			Command:   status.Command.String(),
+			Capacity:  status.Capacity,
+			Remaining: status.Remaining,
+			NextUse:   status.NextUse.UnixMilli(),
+		})
+	}
+
+	return resp, nil
+}
+
 var (
 	itemType      = reflect.TypeOf([]components.Item{})
 	characterType = reflect.TypeOf([]components.Character{})
@@ -212,8 +430,33 @@ var (
 	encType       = reflect.TypeOf([]events.EncounterEvent{})
 	liveType      = reflect.TypeOf([]events.LiveMutationEvent{})
 	dieType       = reflect.TypeOf([]events.DieMutationEvent{})
+	recipeType    = reflect.TypeOf([]components.Recipe{})
 )
 
+// assetParser returns the loader key and parser of an asset type.
+func assetParser(asset proto.AssetType) (reflect.Type, util.Parser, bool) {
+	switch asset {
+	case proto.AssetType_ItemAsset:
+		return itemType, decodeItems, true
+	case proto.AssetType_CharacterAsset:
+		return characterType, decodeCharacters, true
+	case proto.AssetType_ItemDecisionAsset:
+		return decType, json.Unmarshal, true
+	case proto.AssetType_ActionAsset:
+		return actionType, json.Unmarshal, true
+	case proto.AssetType_EncounterAsset:
+		return encType, json.Unmarshal, true
+	case proto.AssetType_LiveMutationAsset:
+		return liveType, json.Unmarshal, true
+	case proto.AssetType_DieMutationAsset:
+		return dieType, json.Unmarshal, true
+	case proto.AssetType_RecipeAsset:
+		return recipeType, decodeRecipes, true
+	default:
+		return nil, nil, false
+	}
+}
+
 func decodeItems(data []byte, val any) error {
 	items, err := parse.ItemsFromJSON(data)
 	if err != nil {
@@ -236,6 +479,34 @@ func decodeCharacters(data []byte, val any) error {
 	return nil
 }
 
+func decodeRecipes(data []byte, val any) error {
+	recipes, err := parse.RecipesFromJSON(data)
+	if err != nil {
+		return err
+	}
+
+	reflect.Indirect(reflect.ValueOf(val)).Set(reflect.ValueOf(recipes))
+
+	return nil
+}
+
+// loadProfile converts a requested profile. Backpacks of profiles created before stacks existed are migrated with
+// the weights of the item assets so that migrated items count toward carry capacity.
+func (s *Server) loadProfile(profile *proto.Profile) (components.Profile, error) {
+	loaded := protoToProfile(profile)
+
+	if types := legacyBackpack(profile); types != nil {
+		items, err := s.items.Items()
+		if err != nil {
+			return loaded, err
+		}
+
+		loaded.Backpack = components.NewBackpackFromItems(types, items)
+	}
+
+	return loaded, nil
+}
+
 func protoToProfile(profile *proto.Profile) components.Profile {
 	return components.Profile{
 		UUID:          profile.Uuid,
@@ -244,7 +515,8 @@ func protoToProfile(profile *proto.Profile) components.Profile {
 		Active:        protoToCharacterNil(profile.Active),
 		ActiveItem:    protoToActiveItem(profile.ActiveItem),
 		BackpackLimit: uint8(profile.BackpackLimit),
-		Backpack:      protoToBackpack(profile.Backpack),
+		CarryCapacity: profile.CarryCapacity,
+		Backpack:      protoToBackpack(profile),
 		Stats:         protoToStats(profile.Stats),
 		Limits:        protoToLimits(profile.Limits),
 	}
@@ -258,7 +530,8 @@ func profileToProto(profile *components.Profile) *proto.Profile {
 		Active:        characterNilToProto(profile.Active),
 		ActiveItem:    activeItemToProto(profile.ActiveItem),
 		BackpackLimit: uint32(profile.BackpackLimit),
-		Backpack:      backpackToProto(profile.Backpack),
+		CarryCapacity: profile.CarryCapacity,
+		Stacks:        backpackToProto(profile.Backpack),
 		Stats:         statsToProto(profile.Stats),
 		Limits:        limitsToProto(profile.Limits),
 	}
@@ -302,6 +575,52 @@ func characterToProto(char components.Character) *proto.Character {
 	}
 }
 
+func diffToProto(diff components.ProfileDiff) *proto.ProfileDiff {
+	backpack := make([]*proto.QuantityChange, len(diff.Backpack))
+	for idx, change := range diff.Backpack {
+		backpack[idx] = &proto.QuantityChange{
+			Type:     uint64(change.Type),
+			Quantity: change.Quantity,
+		}
+	}
+
+	return &proto.ProfileDiff{
+		Xp:                diff.XP,
+		Currency:          diff.Currency,
+		Stats:             statsToProto(diff.Stats),
+		Backpack:          backpack,
+		ActiveItemChanged: diff.ActiveItemChanged,
+		ActiveItem:        activeItemToProto(diff.ActiveItem),
+		CharacterChanged:  diff.CharacterChanged,
+		Character:         characterNilToProto(diff.Character),
+	}
+}
+
+func protoToDiff(diff *proto.ProfileDiff) components.ProfileDiff {
+	if diff == nil {
+		return components.ProfileDiff{}
+	}
+
+	backpack := make([]components.QuantityChange, len(diff.GetBackpack()))
+	for idx, change := range diff.GetBackpack() {
+		backpack[idx] = components.QuantityChange{
+			Type:     components.ItemType(change.GetType()),
+			Quantity: change.GetQuantity(),
+		}
+	}
+
+	return components.ProfileDiff{
+		XP:                diff.GetXp(),
+		Currency:          diff.GetCurrency(),
+		Stats:             protoToStats(diff.GetStats()),
+		Backpack:          backpack,
+		ActiveItemChanged: diff.GetActiveItemChanged(),
+		ActiveItem:        protoToActiveItem(diff.ActiveItem),
+		CharacterChanged:  diff.GetCharacterChanged(),
+		Character:         protoToCharacterNil(diff.GetCharacter()),
+	}
+}
+
 func protoToActiveItem(item *uint64) *components.ItemType {
 	if item == nil {
 		return nil
@@ -322,22 +641,47 @@ func activeItemToProto(tp *components.ItemType) *uint64 {
 	return &val
 }
 
-func protoToBackpack(items []uint64) []components.ItemType {
-	list := make([]components.ItemType, len(items))
-	for idx, value := range items {
-		list[idx] = components.ItemType(value)
+func protoToBackpack(profile *proto.Profile) components.Backpack {
+	if types := legacyBackpack(profile); types != nil {
+		return components.NewBackpackFromItems(types, nil)
 	}
 
-	return list
+	return mutateListValues(profile.Stacks, protoToStack)
 }
 
-func backpackToProto(backpack []components.ItemType) []uint64 {
-	slice := make([]uint64, len(backpack))
-	for idx, value := range backpack {
-		slice[idx] = uint64(value)
+// legacyBackpack returns the flat list of item types carried by profiles created before stacks existed and nil for
+// all other profiles.
+func legacyBackpack(profile *proto.Profile) []components.ItemType {
+	if len(profile.GetStacks()) > 0 || len(profile.GetBackpack()) == 0 {
+		return nil
+	}
+
+	types := make([]components.ItemType, len(profile.GetBackpack()))
+	for idx, value := range profile.GetBackpack() {
+		types[idx] = components.ItemType(value)
 	}
 
-	return slice
+	return types
+}
+
+func backpackToProto(backpack components.Backpack) []*proto.ItemStack {
+	return mutateListValues(backpack, stackToProto)
+}
+
+func protoToStack(stack *proto.ItemStack) components.Stack {
+	return components.Stack{
+		Type:     components.ItemType(stack.Type),
+		Quantity: stack.Quantity,
+		Weight:   stack.Weight,
+	}
+}
+
+func stackToProto(stack components.Stack) *proto.ItemStack {
+	return &proto.ItemStack{
+		Type:     uint64(stack.Type),
+		Quantity: stack.Quantity,
+		Weight:   stack.Weight,
+	}
 }
 
 func protoToStats(stats *proto.Stats) components.Stats {
@@ -362,9 +706,23 @@ func protoToLimits(limits *proto.Limits) *components.Limits {
 		panic(err)
 	}
 
+	var commands map[string]components.CommandLimit
+	if len(limits.Commands) > 0 {
+		commands = make(map[string]components.CommandLimit, len(limits.Commands))
+
+		for name, limit := range limits.Commands {
+			commands[name] = components.CommandLimit{
+				Last:  time.UnixMilli(limit.GetLast()),
+				Count: limit.GetCount(),
+			}
+		}
+	}
+
 	return &components.Limits{
 		LastWalk:  time.UnixMilli(limits.LastWalk),
 		WalkCount: val,
+		Commands:  commands,
+		LastRest:  time.UnixMilli(limits.GetLastRest()),
 	}
 }
 
@@ -373,9 +731,19 @@ func limitsToProto(limits *components.Limits) *proto.Limits {
 		return nil
 	}
 
+	commands := make(map[string]*proto.CommandLimit, len(limits.Commands))
+	for name, limit := range limits.Commands {
+		commands[name] = &proto.CommandLimit{
+			Last:  limit.Last.UnixMilli(),
+			Count: limit.Count,
+		}
+	}
+
 	return &proto.Limits{
 		LastWalk:  limits.LastWalk.UnixMilli(),
 		WalkCount: strconv.FormatUint(limits.WalkCount, 10),
+		Commands:  commands,
+		LastRest:  limits.LastRest.UnixMilli(),
 	}
 }
 
@@ -409,15 +777,68 @@ func getLoaderType(req *proto.LoadRequest) (util.Loader, error) {
 
 func itemToProto(item components.Item) *proto.Item {
 	return &proto.Item{
-		Type: uint64(item.Type),
-		Name: item.Name,
+		Type:      uint64(item.Type),
+		Name:      item.Name,
+		StackSize: item.StackSize,
+		Weight:    item.Weight,
+		Rest:      item.Rest,
+		Rare:      item.Rare,
 	}
 }
 
 func protoToItem(item *proto.Item) components.Item {
 	return components.Item{
-		Type: components.ItemType(item.Type),
-		Name: item.Name,
+		Type:      components.ItemType(item.Type),
+		Name:      item.Name,
+		StackSize: item.StackSize,
+		Weight:    item.Weight,
+		Rest:      item.Rest,
+		Rare:      item.Rare,
+	}
+}
+
+func recipeToProto(recipe components.Recipe) *proto.Recipe {
+	var requires *proto.Stats
+	if recipe.Requires != nil {
+		requires = statsToProto(*recipe.Requires)
+	}
+
+	return &proto.Recipe{
+		Type:     uint64(recipe.Type),
+		Name:     recipe.Name,
+		Inputs:   mutateListValues(recipe.Inputs, ingredientToProto),
+		Output:   ingredientToProto(recipe.Output),
+		Requires: requires,
+	}
+}
+
+func protoToRecipe(recipe *proto.Recipe) components.Recipe {
+	var requires *components.Stats
+	if recipe.Requires != nil {
+		stats := protoToStats(recipe.Requires)
+		requires = &stats
+	}
+
+	return components.Recipe{
+		Type:     components.RecipeType(recipe.Type),
+		Name:     recipe.Name,
+		Inputs:   mutateListValues(recipe.Inputs, protoToIngredient),
+		Output:   protoToIngredient(recipe.Output),
+		Requires: requires,
+	}
+}
+
+func ingredientToProto(ingredient components.Ingredient) *proto.Ingredient {
+	return &proto.Ingredient{
+		Item:     uint64(ingredient.Item),
+		Quantity: ingredient.Quantity,
+	}
+}
+
+func protoToIngredient(ingredient *proto.Ingredient) components.Ingredient {
+	return components.Ingredient{
+		Item:     components.ItemType(ingredient.GetItem()),
+		Quantity: ingredient.GetQuantity(),
 	}
 }
 
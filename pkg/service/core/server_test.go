@@ -0,0 +1,136 @@
+package core_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ciphermountain/deadenz/pkg/middleware"
+	proto "github.com/ciphermountain/deadenz/pkg/proto/core"
+	"github.com/ciphermountain/deadenz/pkg/service/core"
+)
+
+func TestServer_Status(t *testing.T) {
+	t.Parallel()
+
+	server, err := core.NewServer(nil, middleware.ChainConfig{
+		PreRun: []middleware.Config{
+			{
+				Name:   "command_limiter",
+				Params: json.RawMessage(`{"command": "craft", "capacity": 2, "regeneration": "10m"}`),
+			},
+		},
+	})
+
+	require.NoError(t, err)
+
+	t.Cleanup(func() { server.Close() })
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := server.Status(context.Background(), &proto.StatusRequest{})
+
+		require.NoError(t, err)
+		assert.Equal(t, proto.Status_Failure, resp.GetResponse().GetStatus())
+		assert.Equal(t, proto.ErrorCode_NilProfile, resp.GetResponse().GetError().GetCode())
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("unused commands report full capacity", func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := server.Status(context.Background(), &proto.StatusRequest{Profile: &proto.Profile{}})
+
+			require.NoError(t, err)
+			require.Len(t, resp.GetCommands(), 1)
+
+			assert.Equal(t, "craft", resp.GetCommands()[0].GetCommand())
+			assert.Equal(t, uint64(2), resp.GetCommands()[0].GetRemaining())
+		})
+
+		t.Run("limited commands report the next use", func(t *testing.T) {
+			t.Parallel()
+
+			last := time.Now().Add(-time.Minute)
+			resp, err := server.Status(context.Background(), &proto.StatusRequest{
+				Profile: &proto.Profile{
+					Limits: &proto.Limits{
+						WalkCount: "0",
+						Commands: map[string]*proto.CommandLimit{
+							"craft": {Last: last.UnixMilli(), Count: 2},
+						},
+					},
+				},
+			})
+
+			require.NoError(t, err)
+			require.Len(t, resp.GetCommands(), 1)
+
+			status := resp.GetCommands()[0]
+
+			assert.Equal(t, uint64(2), status.GetCapacity())
+			assert.Equal(t, uint64(0), status.GetRemaining())
+			assert.Equal(t, last.Add(10*time.Minute).UnixMilli(), status.GetNextUse())
+		})
+	})
+}
+
+func TestServer_Run(t *testing.T) {
+	t.Parallel()
+
+	server, err := core.NewServer(nil, middleware.ChainConfig{})
+
+	require.NoError(t, err)
+
+	t.Cleanup(func() { server.Close() })
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name string
+			req  *proto.RunRequest
+			code proto.ErrorCode
+		}{
+			{
+				name: "nil profile",
+				req:  &proto.RunRequest{Command: &proto.RunRequest_Spawnin{Spawnin: &proto.SpawninCommand{}}},
+				code: proto.ErrorCode_NilProfile,
+			},
+			{
+				name: "missing command",
+				req:  &proto.RunRequest{Profile: &proto.Profile{}},
+				code: proto.ErrorCode_UnrecognizedCommand,
+			},
+			{
+				name: "unregistered named command",
+				req: &proto.RunRequest{
+					Profile: &proto.Profile{},
+					Command: &proto.RunRequest_Named{Named: &proto.NamedCommand{Name: "dance"}},
+				},
+				code: proto.ErrorCode_UnrecognizedCommand,
+			},
+		}
+
+		for _, test := range tests {
+			test := test
+
+			t.Run(test.name, func(t *testing.T) {
+				t.Parallel()
+
+				resp, err := server.Run(context.Background(), test.req)
+
+				require.NoError(t, err)
+				assert.Equal(t, proto.Status_Failure, resp.GetResponse().GetStatus())
+				assert.Equal(t, test.code, resp.GetResponse().GetError().GetCode())
+			})
+		}
+	})
+}
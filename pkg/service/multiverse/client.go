@@ -3,20 +3,58 @@ package multiverse
 import (
 	"context"
 	"errors"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/ciphermountain/deadenz/pkg/components"
 	proto "github.com/ciphermountain/deadenz/pkg/proto/multiverse"
 )
 
+var (
+	_ Peer   = &Client{}
+	_ Syncer = &Client{}
+)
+
+var ErrIncompleteBatch = errors.New("multiverse did not respond to every event of the batch")
+
 type Client struct {
 	grpcClient proto.MultiverseClient
+	signer     *Signer
+	creds      credentials.TransportCredentials
+}
+
+// ClientOpt configures optional behaviour of a multiverse client.
+type ClientOpt func(*Client)
+
+// WithSigner signs every published game event, registration and deregistration so that a multiverse requiring
+// authentication accepts them.
+func WithSigner(signer *Signer) ClientOpt {
+	return func(c *Client) {
+		c.signer = signer
+	}
 }
 
-func NewClient(addr string) (*Client, error) {
+// WithCredentials dials the multiverse with the provided credentials instead of an insecure connection.
+func WithCredentials(creds credentials.TransportCredentials) ClientOpt {
+	return func(c *Client) {
+		c.creds = creds
+	}
+}
+
+func NewClient(addr string, clientOpts ...ClientOpt) (*Client, error) {
+	client := &Client{
+		creds: insecure.NewCredentials(),
+	}
+
+	for _, opt := range clientOpts {
+		opt(client)
+	}
+
 	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(client.creds),
 	}
 
 	conn, err := grpc.Dial(addr, opts...)
@@ -24,26 +62,200 @@ func NewClient(addr string) (*Client, error) {
 		return nil, err
 	}
 
-	return &Client{
-		grpcClient: proto.NewMultiverseClient(conn),
-	}, nil
+	client.grpcClient = proto.NewMultiverseClient(conn)
+
+	return client, nil
 }
 
 func (c *Client) PublishGameEvent(ctx context.Context, id string, data []byte) error {
-	resp, err := c.grpcClient.PublishGameEvent(ctx, &proto.GameEvent{
+	event := &proto.GameEvent{
 		Uid:  id,
 		Data: data,
+	}
+
+	if c.signer != nil {
+		c.signer.Sign(event, time.Now())
+	}
+
+	resp, err := c.grpcClient.PublishGameEvent(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	if resp.Status == proto.Status_Failure {
+		return errors.New(resp.Message)
+	}
+
+	return nil
+}
+
+// PublishGameEvents publishes the events as a single batch. The returned slice holds the result of each event in
+// order and the error is only set when the batch was not delivered.
+func (c *Client) PublishGameEvents(ctx context.Context, events []*proto.GameEvent) ([]error, error) {
+	if c.signer != nil {
+		now := time.Now()
+
+		for _, event := range events {
+			c.signer.Sign(event, now)
+		}
+	}
+
+	resp, err := c.grpcClient.PublishGameEvents(ctx, &proto.GameEventBatch{Events: events})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.GetResponses()) != len(events) {
+		return nil, ErrIncompleteBatch
+	}
+
+	results := make([]error, len(events))
+
+	for idx, eventResp := range resp.GetResponses() {
+		if eventResp.GetStatus() != proto.Status_Failure {
+			continue
+		}
+
+		// a replay means an earlier attempt was applied
+		if eventResp.GetMessage() == ErrReplayedEvent.Error() {
+			results[idx] = ErrReplayedEvent
+		} else {
+			results[idx] = errors.New(eventResp.GetMessage())
+		}
+	}
+
+	return results, nil
+}
+
+// Register records the character the player is playing and returns once the multiverse acknowledges it.
+func (c *Client) Register(ctx context.Context, id string, character components.CharacterType) error {
+	reg := &proto.Registration{
+		Uid:       id,
+		Character: uint64(character),
+	}
+
+	if c.signer != nil {
+		c.signer.Sign(reg, time.Now())
+	}
+
+	resp, err := c.grpcClient.Register(ctx, reg)
+	if err != nil {
+		return err
+	}
+
+	if resp.Status == proto.Status_Failure {
+		return errors.New(resp.Message)
+	}
+
+	return nil
+}
+
+// Deregister removes the player from the multiverse and returns once the multiverse acknowledges it.
+func (c *Client) Deregister(ctx context.Context, id string) error {
+	dereg := &proto.Deregistration{
+		Uid: id,
+	}
+
+	if c.signer != nil {
+		c.signer.Sign(dereg, time.Now())
+	}
+
+	resp, err := c.grpcClient.Deregister(ctx, dereg)
+	if err != nil {
+		return err
+	}
+
+	if resp.Status == proto.Status_Failure {
+		return errors.New(resp.Message)
+	}
+
+	return nil
+}
+
+// Announce broadcasts a server announcement to every player.
+func (c *Client) Announce(ctx context.Context, message string) error {
+	resp, err := c.grpcClient.Announce(ctx, &proto.Announcement{
+		Message: message,
 	})
+	if err != nil {
+		return err
+	}
 
 	if resp.Status == proto.Status_Failure {
 		return errors.New(resp.Message)
 	}
 
-	return err
+	return nil
 }
 
-func (c *Client) NewEventsStreamReader(ctx context.Context, id string) (*EventsReader, error) {
-	events, err := c.grpcClient.Events(ctx, &proto.Filter{Uid: id, Recipients: []string{id}})
+// FilterOpt narrows the events received by an events stream.
+type FilterOpt func(*proto.Filter)
+
+// WithEventTypes only receives events of the provided types.
+func WithEventTypes(types ...proto.EventType) FilterOpt {
+	return func(filter *proto.Filter) {
+		filter.Types = append(filter.Types, types...)
+	}
+}
+
+// WithCharacters only receives events about the provided character types.
+func WithCharacters(characters ...components.CharacterType) FilterOpt {
+	return func(filter *proto.Filter) {
+		for _, character := range characters {
+			filter.Characters = append(filter.Characters, uint64(character))
+		}
+	}
+}
+
+// WithPlayers only receives events caused by the provided players.
+func WithPlayers(uids ...string) FilterOpt {
+	return func(filter *proto.Filter) {
+		filter.Recipients = append(filter.Recipients, uids...)
+	}
+}
+
+// Relay forwards a change accepted by another node. It makes the client usable as a peer of a multiverse node.
+func (c *Client) Relay(ctx context.Context, change *proto.Relayed) error {
+	resp, err := c.grpcClient.Relay(ctx, change)
+	if err != nil {
+		return err
+	}
+
+	if resp.Status == proto.Status_Failure {
+		return errors.New(resp.Message)
+	}
+
+	return nil
+}
+
+// Sync returns the character registry snapshot of the node.
+func (c *Client) Sync(ctx context.Context, node string) ([]byte, error) {
+	snapshot, err := c.grpcClient.Sync(ctx, &proto.SyncRequest{Node: node})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot.GetData(), nil
+}
+
+// WithResumeFrom first replays the retained events starting at the sequence, such as the one after the last
+// event received before a disconnect.
+func WithResumeFrom(sequence uint64) FilterOpt {
+	return func(filter *proto.Filter) {
+		filter.ResumeFrom = sequence
+	}
+}
+
+// NewEventsStreamReader subscribes the player to the multiverse. Without options every event routed to the player
+// is received.
+func (c *Client) NewEventsStreamReader(ctx context.Context, id string, opts ...FilterOpt) (*EventsReader, error) {
+	filter := &proto.Filter{Uid: id}
+
+	for _, opt := range opts {
+		opt(filter)
+	}
+
+	events, err := c.grpcClient.Events(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,186 @@
+package multiverse
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	proto "github.com/ciphermountain/deadenz/pkg/proto/multiverse"
+)
+
+const (
+	// RelayTimeout bounds how long relaying a single change to a peer may take.
+	RelayTimeout = 5 * time.Second
+	// relayQueueSize is the number of changes waiting to be relayed to a peer before new changes are dropped.
+	relayQueueSize = 1000
+)
+
+var (
+	ErrMissingChange   = errors.New("relayed change is empty")
+	ErrMissingChangeID = errors.New("relayed change id required")
+)
+
+// Peer is another multiverse node that accepted changes are relayed to.
+type Peer interface {
+	Relay(ctx context.Context, change *proto.Relayed) error
+}
+
+// Syncer is another multiverse node that a joining node copies the character registry from.
+type Syncer interface {
+	Sync(ctx context.Context, node string) ([]byte, error)
+}
+
+// federation relays every change accepted by a node to its peers and remembers the changes it has seen so that a
+// change arriving from several peers is only applied once.
+type federation struct {
+	node   string
+	relays []*peerRelay
+	next   atomic.Uint64
+
+	mu     sync.Mutex
+	seen   map[string]time.Time
+	closed bool
+}
+
+func newFederation(node string, peers []Peer) *federation {
+	if node == "" {
+		node = randomNodeID()
+	}
+
+	fed := &federation{
+		node: node,
+		seen: make(map[string]time.Time),
+	}
+
+	for _, peer := range peers {
+		fed.relays = append(fed.relays, newPeerRelay(peer))
+	}
+
+	return fed
+}
+
+// newID returns an id for a change accepted by this node that is unique across the federation.
+func (f *federation) newID() string {
+	return fmt.Sprintf("%s-%d", f.node, f.next.Add(1))
+}
+
+// markSeen records the change and indicates whether it had not been seen before.
+func (f *federation) markSeen(id string, now time.Time) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.seen[id]; ok {
+		return false
+	}
+
+	f.seen[id] = now
+
+	return true
+}
+
+// forward queues the change to be relayed to every peer.
+func (f *federation) forward(change *proto.Relayed) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return
+	}
+
+	for _, relay := range f.relays {
+		relay.send(change)
+	}
+}
+
+// prune forgets changes seen before the retention window. A change relayed again after it is forgotten would be
+// applied twice so the window must be longer than relaying takes.
+func (f *federation) prune(now time.Time, retention time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for id, at := range f.seen {
+		if now.Sub(at) > retention {
+			delete(f.seen, id)
+		}
+	}
+}
+
+// close stops relaying once every queued change has been attempted.
+func (f *federation) close() {
+	f.mu.Lock()
+
+	if f.closed {
+		f.mu.Unlock()
+
+		return
+	}
+
+	f.closed = true
+	f.mu.Unlock()
+
+	for _, relay := range f.relays {
+		relay.close()
+	}
+}
+
+// peerRelay relays changes to a single peer in the order they were accepted.
+type peerRelay struct {
+	peer  Peer
+	queue chan *proto.Relayed
+	done  chan struct{}
+}
+
+func newPeerRelay(peer Peer) *peerRelay {
+	relay := &peerRelay{
+		peer:  peer,
+		queue: make(chan *proto.Relayed, relayQueueSize),
+		done:  make(chan struct{}),
+	}
+
+	go relay.run()
+
+	return relay
+}
+
+func (r *peerRelay) send(change *proto.Relayed) {
+	select {
+	case r.queue <- change:
+	default:
+		log.Printf("relay queue full; dropping change %s", change.GetId())
+	}
+}
+
+func (r *peerRelay) run() {
+	defer close(r.done)
+
+	for change := range r.queue {
+		ctx, cancel := context.WithTimeout(context.Background(), RelayTimeout)
+
+		if err := r.peer.Relay(ctx, change); err != nil {
+			log.Printf("failed to relay change %s: %s", change.GetId(), err.Error())
+		}
+
+		cancel()
+	}
+}
+
+func (r *peerRelay) close() {
+	close(r.queue)
+	<-r.done
+}
+
+func randomNodeID() string {
+	data := make([]byte, 8)
+
+	if _, err := rand.Read(data); err != nil {
+		panic(err)
+	}
+
+	return hex.EncodeToString(data)
+}
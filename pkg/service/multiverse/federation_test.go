@@ -0,0 +1,213 @@
+package multiverse
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ciphermountain/deadenz/pkg/components"
+	"github.com/ciphermountain/deadenz/pkg/events"
+	proto "github.com/ciphermountain/deadenz/pkg/proto/multiverse"
+)
+
+func TestFederation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("registrations reach nodes that are not directly peered", func(t *testing.T) {
+		t.Parallel()
+
+		nodes := newFederatedNodes(t, [][]int{{1}, {0, 2}, {1}})
+
+		resp, err := nodes[0].Register(context.Background(), &proto.Registration{Uid: "a", Character: 4})
+
+		require.NoError(t, err)
+		require.Equal(t, proto.Status_OK, resp.GetStatus())
+
+		require.Eventually(t, func() bool {
+			character, ok := nodes[2].characters.Character("a")
+
+			return ok && character == 4
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("changes are applied once in a fully peered universe", func(t *testing.T) {
+		t.Parallel()
+
+		nodes := newFederatedNodes(t, [][]int{{1, 2}, {0, 2}, {0, 1}})
+
+		stream, closeStream := newFakeStream()
+		defer closeStream()
+
+		nodes[0].track("a")
+
+		go func() { _ = nodes[0].Events(&proto.Filter{Uid: "a", ResumeFrom: 1}, stream) }()
+
+		announce(t, nodes[2], "hello")
+		announce(t, nodes[1], "goodbye")
+
+		received := make(map[string]int)
+
+		for idx := 0; idx < 2; idx++ {
+			received[(<-stream.sent).GetAnnouncement().GetMessage()]++
+		}
+
+		assert.Equal(t, map[string]int{"hello": 1, "goodbye": 1}, received)
+
+		// relaying settles after every node has seen both announcements
+		time.Sleep(50 * time.Millisecond)
+
+		select {
+		case evt := <-stream.sent:
+			t.Fatalf("duplicate event received: %s", evt.GetAnnouncement().GetMessage())
+		default:
+		}
+	})
+
+	t.Run("signed registrations reach peers that verify them", func(t *testing.T) {
+		t.Parallel()
+
+		nodes := newFederatedNodes(t, [][]int{{1}, {0}}, withTestVerifier)
+		reg := &proto.Registration{Uid: "a", Character: 4}
+
+		NewSigner("core", []byte("secret")).Sign(reg, time.Now())
+
+		resp, err := nodes[0].Register(context.Background(), reg)
+
+		require.NoError(t, err)
+		require.Equal(t, proto.Status_OK, resp.GetStatus())
+
+		require.Eventually(t, func() bool {
+			character, ok := nodes[1].characters.Character("a")
+
+			return ok && character == 4
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("relayed changes are verified", func(t *testing.T) {
+		t.Parallel()
+
+		server := NewMultiverseServer(WithPeerIdentities(testPeer), withTestVerifier)
+		defer server.Close()
+
+		server.characters.Register("victim", 4)
+
+		data, err := json.Marshal(events.NewDieMutationEventWithCharacter(
+			components.Character{Type: 4},
+			events.NewDieMutationEvent("was struck by lightning"),
+		))
+		require.NoError(t, err)
+
+		relay := func(change *proto.Relayed) *proto.Response {
+			resp, err := server.Relay(peerContext(context.Background(), testPeer), change)
+			require.NoError(t, err)
+
+			return resp
+		}
+
+		unsigned := relay(&proto.Relayed{
+			Id:     "unsigned",
+			Change: &proto.Relayed_GameEvent{GameEvent: &proto.GameEvent{Id: "unsigned", Uid: "a", Data: data}},
+		})
+
+		assert.Equal(t, proto.Status_Failure, unsigned.GetStatus())
+		assert.Equal(t, ErrUnsignedEvent.Error(), unsigned.GetMessage())
+
+		signed := &proto.GameEvent{Uid: "a", Data: data}
+		NewSigner("core", []byte("secret")).Sign(signed, time.Now())
+
+		renamed := relay(&proto.Relayed{
+			Id:     "renamed",
+			Change: &proto.Relayed_GameEvent{GameEvent: signed},
+		})
+
+		assert.Equal(t, ErrMismatchedEventID.Error(), renamed.GetMessage())
+
+		reg := relay(&proto.Relayed{
+			Id:     "registration",
+			Change: &proto.Relayed_Registration{Registration: &proto.Registration{Uid: "b", Character: 4}},
+		})
+
+		assert.Equal(t, ErrUnsignedEvent.Error(), reg.GetMessage())
+
+		// only the verified death kills the character
+		_, alive := server.characters.Character("victim")
+		assert.True(t, alive)
+
+		accepted := relay(&proto.Relayed{
+			Id:     signed.GetId(),
+			Change: &proto.Relayed_GameEvent{GameEvent: signed},
+		})
+
+		assert.Equal(t, proto.Status_OK, accepted.GetStatus())
+
+		_, alive = server.characters.Character("victim")
+		assert.False(t, alive)
+	})
+
+	t.Run("a relayed change without an id is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		server := NewMultiverseServer(WithPeerIdentities(testPeer))
+		defer server.Close()
+
+		resp, err := server.Relay(peerContext(context.Background(), testPeer), &proto.Relayed{
+			Change: &proto.Relayed_Deregistration{Deregistration: &proto.Deregistration{Uid: "a"}},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, proto.Status_Failure, resp.GetStatus())
+		assert.Equal(t, ErrMissingChangeID.Error(), resp.GetMessage())
+	})
+}
+
+// localPeer relays changes directly to an in-process node.
+type localPeer struct {
+	node *MultiverseServer
+}
+
+func (p *localPeer) Relay(ctx context.Context, change *proto.Relayed) error {
+	_, err := p.node.Relay(peerContext(ctx, testPeer), change)
+
+	return err
+}
+
+// withTestVerifier gives each node its own verifier of the test key.
+func withTestVerifier(s *MultiverseServer) {
+	WithVerifier(NewVerifier(Keys{"core": []byte("secret")}, time.Minute))(s)
+}
+
+// newFederatedNodes creates a node for each entry of the topology where each entry lists the peers of the node.
+func newFederatedNodes(t *testing.T, topology [][]int, nodeOpts ...ServerOpt) []*MultiverseServer {
+	t.Helper()
+
+	peers := make([]*localPeer, len(topology))
+	for idx := range peers {
+		peers[idx] = &localPeer{}
+	}
+
+	nodes := make([]*MultiverseServer, len(topology))
+
+	for idx, peered := range topology {
+		opts := []ServerOpt{WithNodeID(string(rune('a' + idx))), WithPeerIdentities(testPeer)}
+		opts = append(opts, nodeOpts...)
+
+		for _, peer := range peered {
+			opts = append(opts, WithPeers(peers[peer]))
+		}
+
+		nodes[idx] = NewMultiverseServer(opts...)
+		peers[idx].node = nodes[idx]
+	}
+
+	t.Cleanup(func() {
+		for _, node := range nodes {
+			node.Close()
+		}
+	})
+
+	return nodes
+}
@@ -0,0 +1,121 @@
+package multiverse
+
+import (
+	"sync"
+	"time"
+
+	protobuf "google.golang.org/protobuf/proto"
+
+	proto "github.com/ciphermountain/deadenz/pkg/proto/multiverse"
+)
+
+const (
+	// DefaultRetention is how long events are kept for players to resume from when no retention is configured.
+	DefaultRetention = 10 * time.Minute
+	// MaxRetainedEvents caps the number of events kept for each player regardless of the retention window.
+	MaxRetainedEvents = 1000
+)
+
+// logEntry is a sequenced event along with the topic used to filter it when read.
+type logEntry struct {
+	sequence uint64
+	at       time.Time
+	event    *proto.Event
+	topic    topic
+}
+
+// eventLog is the ordered log of events routed to a single player. Events are kept for the retention window so
+// that every stream of the player reads them in order and a reconnecting stream can catch up.
+type eventLog struct {
+	mu        sync.Mutex
+	retention time.Duration
+	next      uint64
+	entries   []logEntry
+	// notify is closed and replaced each time an event is appended
+	notify chan struct{}
+}
+
+func newEventLog(retention time.Duration) *eventLog {
+	return &eventLog{
+		retention: retention,
+		next:      1,
+		notify:    make(chan struct{}),
+	}
+}
+
+// append adds the event to the log with the next sequence and wakes every waiting reader.
+func (l *eventLog) append(event *proto.Event, t topic, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	sequenced, _ := protobuf.Clone(event).(*proto.Event)
+	sequenced.Sequence = l.next
+
+	l.entries = append(l.entries, logEntry{
+		sequence: l.next,
+		at:       now,
+		event:    sequenced,
+		topic:    t,
+	})
+	l.next++
+
+	l.pruneLocked(now)
+
+	close(l.notify)
+	l.notify = make(chan struct{})
+}
+
+// since returns the retained entries starting at the sequence along with a channel that is closed when a newer
+// entry is appended. A sequence beyond the end of the log, such as one from before the log was recreated, returns
+// every retained entry.
+func (l *eventLog) since(sequence uint64) ([]logEntry, <-chan struct{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if sequence > l.next {
+		sequence = 0
+	}
+
+	idx := 0
+	for idx < len(l.entries) && l.entries[idx].sequence < sequence {
+		idx++
+	}
+
+	entries := make([]logEntry, len(l.entries)-idx)
+	copy(entries, l.entries[idx:])
+
+	return entries, l.notify
+}
+
+// head returns the sequence the next appended event will have.
+func (l *eventLog) head() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.next
+}
+
+// prune drops expired entries and indicates whether the log is empty.
+func (l *eventLog) prune(now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.pruneLocked(now)
+
+	return len(l.entries) == 0
+}
+
+func (l *eventLog) pruneLocked(now time.Time) {
+	drop := 0
+	for drop < len(l.entries) && now.Sub(l.entries[drop].at) > l.retention {
+		drop++
+	}
+
+	if excess := len(l.entries) - drop - MaxRetainedEvents; excess > 0 {
+		drop += excess
+	}
+
+	if drop > 0 {
+		l.entries = append([]logEntry(nil), l.entries[drop:]...)
+	}
+}
@@ -0,0 +1,160 @@
+package multiverse
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+var ErrOutboxFull = errors.New("multiverse outbox is full")
+
+// outboxEntry is a game event waiting to be published.
+type outboxEntry struct {
+	ID       string    `json:"id"`
+	UID      string    `json:"uid"`
+	Data     []byte    `json:"data"`
+	Queued   time.Time `json:"queued"`
+	Attempts int       `json:"attempts,omitempty"`
+}
+
+// outbox is a bounded queue of events waiting to be published. When a path is provided every entry is kept in a
+// file of JSON lines so that events survive a restart. The outbox is not safe for concurrent use.
+type outbox struct {
+	path     string
+	capacity int
+	entries  []outboxEntry
+}
+
+// openOutbox restores the entries of the outbox file at the path. A missing file or empty path is an empty outbox.
+func openOutbox(path string, capacity int) (*outbox, error) {
+	box := &outbox{
+		path:     path,
+		capacity: capacity,
+	}
+
+	if path == "" {
+		return box, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return box, nil
+		}
+
+		return nil, err
+	}
+
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		var entry outboxEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, err
+		}
+
+		box.entries = append(box.entries, entry)
+	}
+
+	return box, scanner.Err()
+}
+
+func (o *outbox) len() int {
+	return len(o.entries)
+}
+
+// push adds the entry to the end of the outbox.
+func (o *outbox) push(entry outboxEntry) error {
+	if len(o.entries) >= o.capacity {
+		return ErrOutboxFull
+	}
+
+	if o.path != "" {
+		if err := o.appendToFile(entry); err != nil {
+			return err
+		}
+	}
+
+	o.entries = append(o.entries, entry)
+
+	return nil
+}
+
+// peek returns up to the first n entries.
+func (o *outbox) peek(n int) []outboxEntry {
+	if n > len(o.entries) {
+		n = len(o.entries)
+	}
+
+	entries := make([]outboxEntry, n)
+	copy(entries, o.entries[:n])
+
+	return entries
+}
+
+// remove drops the first n entries.
+func (o *outbox) remove(n int) error {
+	o.entries = append([]outboxEntry(nil), o.entries[n:]...)
+
+	return o.save()
+}
+
+// save replaces the outbox file with the current entries. The file is replaced atomically so that a failed write
+// never loses entries.
+func (o *outbox) save() error {
+	if o.path == "" {
+		return nil
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(o.path), filepath.Base(o.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	writer := bufio.NewWriter(tmp)
+	encoder := json.NewEncoder(writer)
+
+	for _, entry := range o.entries {
+		if err := encoder.Encode(entry); err != nil {
+			tmp.Close()
+
+			return err
+		}
+	}
+
+	if err := writer.Flush(); err != nil {
+		tmp.Close()
+
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), o.path)
+}
+
+func (o *outbox) appendToFile(entry outboxEntry) error {
+	file, err := os.OpenFile(o.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if err := json.NewEncoder(file).Encode(entry); err != nil {
+		file.Close()
+
+		return err
+	}
+
+	return file.Close()
+}
@@ -0,0 +1,75 @@
+package multiverse
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+var ErrUnknownPeer = errors.New("caller is not a configured peer")
+
+// WithPeerIdentities only accepts relayed changes, registry syncs and announcements from callers that present a
+// verified client certificate with one of the names as its common name or a DNS name. Every such call is rejected
+// when no identities are configured, so peered nodes must use mutual TLS unless WithInsecurePeers is used.
+func WithPeerIdentities(names ...string) ServerOpt {
+	return func(s *MultiverseServer) {
+		if s.peerIdentities == nil {
+			s.peerIdentities = make(map[string]struct{}, len(names))
+		}
+
+		for _, name := range names {
+			s.peerIdentities[name] = struct{}{}
+		}
+	}
+}
+
+// WithInsecurePeers accepts relayed changes, registry syncs and announcements from any caller. It is meant for
+// running several nodes locally without client certificates and must not be used where the server is reachable
+// by untrusted callers.
+func WithInsecurePeers() ServerOpt {
+	return func(s *MultiverseServer) {
+		s.insecurePeers = true
+	}
+}
+
+// authorizePeer returns a permission denied error unless peers are not authenticated or the caller presented a
+// verified client certificate of a configured peer.
+func (s *MultiverseServer) authorizePeer(ctx context.Context) error {
+	if s.insecurePeers {
+		return nil
+	}
+
+	for _, name := range peerNames(ctx) {
+		if _, ok := s.peerIdentities[name]; ok {
+			return nil
+		}
+	}
+
+	return status.Error(codes.PermissionDenied, ErrUnknownPeer.Error())
+}
+
+// peerNames returns the names of the verified client certificate of the caller, if any.
+func peerNames(ctx context.Context) []string {
+	caller, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	info, ok := caller.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+
+	leaf := info.State.VerifiedChains[0][0]
+	names := append([]string{}, leaf.DNSNames...)
+
+	if leaf.Subject.CommonName != "" {
+		names = append(names, leaf.Subject.CommonName)
+	}
+
+	return names
+}
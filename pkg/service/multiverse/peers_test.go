@@ -0,0 +1,128 @@
+package multiverse
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/ciphermountain/deadenz/pkg/proto/multiverse"
+)
+
+// testPeer is the certificate name test servers accept peer calls from.
+const testPeer = "peer.test"
+
+func TestMultiverseServer_Peers(t *testing.T) {
+	t.Parallel()
+
+	server := NewMultiverseServer(WithPeerIdentities(testPeer))
+
+	t.Cleanup(func() { server.Close() })
+
+	calls := map[string]func(context.Context) error{
+		"relay": func(ctx context.Context) error {
+			_, err := server.Relay(ctx, &proto.Relayed{
+				Id:     "change",
+				Change: &proto.Relayed_Deregistration{Deregistration: &proto.Deregistration{Uid: "a"}},
+			})
+
+			return err
+		},
+		"sync": func(ctx context.Context) error {
+			_, err := server.Sync(ctx, &proto.SyncRequest{Node: "b"})
+
+			return err
+		},
+		"announce": func(ctx context.Context) error {
+			_, err := server.Announce(ctx, &proto.Announcement{Message: "hello"})
+
+			return err
+		},
+	}
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		callers := map[string]context.Context{
+			"no peer": context.Background(),
+			"no tls":  peer.NewContext(context.Background(), &peer.Peer{}),
+			"unverified certificate": peer.NewContext(context.Background(), &peer.Peer{
+				AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+					PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: testPeer}}},
+				}},
+			}),
+			"unknown certificate": peerContext(context.Background(), "stranger.test"),
+		}
+
+		for callName, call := range calls {
+			for callerName, ctx := range callers {
+				err := call(ctx)
+
+				assert.Equal(t, codes.PermissionDenied, status.Code(err), "%s from %s", callName, callerName)
+			}
+		}
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		for name, call := range calls {
+			require.NoError(t, call(peerContext(context.Background(), testPeer)), name)
+		}
+	})
+}
+
+func TestMultiverseServer_InsecurePeers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("peer calls are denied by default", func(t *testing.T) {
+			t.Parallel()
+
+			server := NewMultiverseServer()
+
+			t.Cleanup(func() { server.Close() })
+
+			_, err := server.Sync(peerContext(context.Background(), testPeer), &proto.SyncRequest{Node: "b"})
+
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		})
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("callers without certificates are accepted", func(t *testing.T) {
+			t.Parallel()
+
+			server := NewMultiverseServer(WithInsecurePeers())
+
+			t.Cleanup(func() { server.Close() })
+
+			_, err := server.Sync(context.Background(), &proto.SyncRequest{Node: "b"})
+			require.NoError(t, err)
+
+			_, err = server.Announce(peer.NewContext(context.Background(), &peer.Peer{}), &proto.Announcement{Message: "hello"})
+			require.NoError(t, err)
+		})
+	})
+}
+
+// peerContext returns a context of a caller that presented a verified client certificate with the name.
+func peerContext(ctx context.Context, name string) context.Context {
+	return peer.NewContext(ctx, &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{{DNSNames: []string{name}}}},
+		}},
+	})
+}
@@ -0,0 +1,336 @@
+package multiverse
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	proto "github.com/ciphermountain/deadenz/pkg/proto/multiverse"
+)
+
+var ErrPublisherClosed = errors.New("multiverse publisher closed")
+
+// BatchPublisher delivers batches of game events to the multiverse.
+type BatchPublisher interface {
+	PublishGameEvents(ctx context.Context, events []*proto.GameEvent) ([]error, error)
+}
+
+// PublisherConfig configures the outbox, batching and retries of a publisher. Zero values use the defaults.
+type PublisherConfig struct {
+	// Outbox is the path of the file events are kept in until published. Events are only kept in memory when empty.
+	Outbox string
+	// Capacity is the most events kept in the outbox. Events published while the outbox is full are dropped.
+	Capacity int
+	// BatchSize is the most events published in a single request.
+	BatchSize int
+	// Linger is how long to wait for more events to fill a batch once an event is queued.
+	Linger time.Duration
+	// Timeout bounds a single batch request.
+	Timeout time.Duration
+	// MinBackoff and MaxBackoff bound the delay between failed attempts which doubles on each failure.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultPublisherConfig returns the configuration used for unset values.
+func DefaultPublisherConfig() PublisherConfig {
+	return PublisherConfig{
+		Capacity:   10000,
+		BatchSize:  50,
+		Linger:     50 * time.Millisecond,
+		Timeout:    5 * time.Second,
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: 30 * time.Second,
+	}
+}
+
+func (c PublisherConfig) withDefaults() PublisherConfig {
+	defaults := DefaultPublisherConfig()
+
+	if c.Capacity <= 0 {
+		c.Capacity = defaults.Capacity
+	}
+
+	if c.BatchSize <= 0 {
+		c.BatchSize = defaults.BatchSize
+	}
+
+	if c.Linger <= 0 {
+		c.Linger = defaults.Linger
+	}
+
+	if c.Timeout <= 0 {
+		c.Timeout = defaults.Timeout
+	}
+
+	if c.MinBackoff <= 0 {
+		c.MinBackoff = defaults.MinBackoff
+	}
+
+	if c.MaxBackoff < c.MinBackoff {
+		c.MaxBackoff = max(defaults.MaxBackoff, c.MinBackoff)
+	}
+
+	return c
+}
+
+// PublisherMetrics counts what happened to events passed to a publisher.
+type PublisherMetrics struct {
+	// Published is the number of events acknowledged by the multiverse.
+	Published uint64
+	// Rejected is the number of events the multiverse refused. Rejected events are not retried.
+	Rejected uint64
+	// Dropped is the number of events lost because the outbox was full or could not be written.
+	Dropped uint64
+	// Delayed is the number of events published only after at least one failed attempt.
+	Delayed uint64
+	// Retries is the number of failed batch attempts.
+	Retries uint64
+	// Pending is the number of events waiting in the outbox.
+	Pending int
+	// MaxDelay is the longest time an event waited in the outbox before being published.
+	MaxDelay time.Duration
+}
+
+// Publisher publishes game events to the multiverse in the background so that a slow or unavailable multiverse
+// does not slow commands. Events wait in a bounded outbox and are sent in batches, retrying with backoff until
+// acknowledged.
+type Publisher struct {
+	client BatchPublisher
+	conf   PublisherConfig
+
+	mu      sync.Mutex
+	outbox  *outbox
+	metrics PublisherMetrics
+
+	wake   chan struct{}
+	stop   chan struct{}
+	done   chan struct{}
+	closer sync.Once
+}
+
+// NewPublisher creates a publisher, restoring any events left in the outbox by a previous run.
+func NewPublisher(client BatchPublisher, conf PublisherConfig) (*Publisher, error) {
+	conf = conf.withDefaults()
+
+	box, err := openOutbox(conf.Outbox, conf.Capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	if box.len() > 0 {
+		log.Printf("restored %d unpublished multiverse events", box.len())
+	}
+
+	publisher := &Publisher{
+		client: client,
+		conf:   conf,
+		outbox: box,
+		wake:   make(chan struct{}, 1),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+
+	go publisher.run()
+
+	return publisher, nil
+}
+
+// PublishGameEvent queues the event to be published. An error is returned when the event could not be queued.
+func (p *Publisher) PublishGameEvent(_ context.Context, uid string, data []byte) error {
+	select {
+	case <-p.stop:
+		return ErrPublisherClosed
+	default:
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	err := p.outbox.push(outboxEntry{
+		ID:     randomID(),
+		UID:    uid,
+		Data:   data,
+		Queued: time.Now(),
+	})
+	if err != nil {
+		p.metrics.Dropped++
+
+		return err
+	}
+
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Metrics returns the current publisher metrics.
+func (p *Publisher) Metrics() PublisherMetrics {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	metrics := p.metrics
+	metrics.Pending = p.outbox.len()
+
+	return metrics
+}
+
+// Close stops publishing after a final attempt to publish queued events. Events that remain are kept in the outbox
+// file for the next run or dropped when the outbox is only in memory.
+func (p *Publisher) Close() error {
+	var err error
+
+	p.closer.Do(func() {
+		close(p.stop)
+		<-p.done
+
+		// publish what can be published without retrying
+		for p.flush() > 0 {
+			continue
+		}
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		if p.outbox.len() == 0 {
+			return
+		}
+
+		if p.conf.Outbox == "" {
+			log.Printf("dropping %d unpublished multiverse events", p.outbox.len())
+
+			p.metrics.Dropped += uint64(p.outbox.len())
+
+			return
+		}
+
+		err = p.outbox.save()
+	})
+
+	return err
+}
+
+func (p *Publisher) run() {
+	defer close(p.done)
+
+	var backoff time.Duration
+
+	for {
+		wait := backoff
+
+		if wait == 0 && p.pending() == 0 {
+			select {
+			case <-p.stop:
+				return
+			case <-p.wake:
+			}
+
+			// wait for more events to fill the batch
+			wait = p.conf.Linger
+		}
+
+		if wait > 0 {
+			timer := time.NewTimer(wait)
+
+			select {
+			case <-p.stop:
+				timer.Stop()
+
+				return
+			case <-timer.C:
+			}
+		}
+
+		if p.flush() < 0 {
+			backoff = min(max(2*backoff, p.conf.MinBackoff), p.conf.MaxBackoff)
+		} else {
+			backoff = 0
+		}
+	}
+}
+
+func (p *Publisher) pending() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.outbox.len()
+}
+
+// flush sends the oldest batch of queued events. It returns the number of events removed from the outbox or -1 if
+// the batch was not delivered.
+func (p *Publisher) flush() int {
+	p.mu.Lock()
+	entries := p.outbox.peek(p.conf.BatchSize)
+	p.mu.Unlock()
+
+	if len(entries) == 0 {
+		return 0
+	}
+
+	batch := make([]*proto.GameEvent, len(entries))
+
+	for idx, entry := range entries {
+		batch[idx] = &proto.GameEvent{
+			Uid:  entry.UID,
+			Data: entry.Data,
+			Id:   entry.ID,
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.conf.Timeout)
+	results, err := p.client.PublishGameEvents(ctx, batch)
+
+	cancel()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err != nil {
+		p.metrics.Retries++
+
+		for idx := range entries {
+			p.outbox.entries[idx].Attempts++
+		}
+
+		log.Printf("failed to publish %d multiverse events and will retry: %s", len(entries), err.Error())
+
+		return -1
+	}
+
+	now := time.Now()
+
+	for idx, entry := range p.outbox.peek(len(entries)) {
+		var result error
+		if idx < len(results) {
+			result = results[idx]
+		}
+
+		// a replay was applied by an earlier attempt whose acknowledgement was lost
+		if result != nil && !errors.Is(result, ErrReplayedEvent) {
+			p.metrics.Rejected++
+
+			log.Printf("multiverse rejected event %s: %s", entry.ID, result.Error())
+
+			continue
+		}
+
+		p.metrics.Published++
+
+		if entry.Attempts > 0 {
+			p.metrics.Delayed++
+		}
+
+		p.metrics.MaxDelay = max(p.metrics.MaxDelay, now.Sub(entry.Queued))
+	}
+
+	if err := p.outbox.remove(len(entries)); err != nil {
+		log.Printf("failed to save multiverse outbox: %s", err.Error())
+	}
+
+	return len(entries)
+}
@@ -0,0 +1,169 @@
+package multiverse_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	proto "github.com/ciphermountain/deadenz/pkg/proto/multiverse"
+	"github.com/ciphermountain/deadenz/pkg/service/multiverse"
+)
+
+func TestPublisher(t *testing.T) {
+	t.Parallel()
+
+	conf := multiverse.PublisherConfig{
+		BatchSize:  3,
+		Linger:     time.Millisecond,
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 5 * time.Millisecond,
+	}
+
+	t.Run("events are published in batches", func(t *testing.T) {
+		t.Parallel()
+
+		client := &fakeBatcher{}
+		publisher, err := multiverse.NewPublisher(client, conf)
+
+		require.NoError(t, err)
+
+		for idx := 0; idx < 7; idx++ {
+			require.NoError(t, publisher.PublishGameEvent(context.Background(), "a", []byte(`{}`)))
+		}
+
+		require.Eventually(t, func() bool { return publisher.Metrics().Published == 7 }, time.Second, time.Millisecond)
+		require.NoError(t, publisher.Close())
+
+		for _, batch := range client.published() {
+			assert.LessOrEqual(t, len(batch), 3)
+		}
+	})
+
+	t.Run("failed batches are retried", func(t *testing.T) {
+		t.Parallel()
+
+		client := &fakeBatcher{failures: 2}
+		publisher, err := multiverse.NewPublisher(client, conf)
+
+		require.NoError(t, err)
+		require.NoError(t, publisher.PublishGameEvent(context.Background(), "a", []byte(`{}`)))
+		require.Eventually(t, func() bool { return publisher.Metrics().Published == 1 }, time.Second, time.Millisecond)
+		require.NoError(t, publisher.Close())
+
+		metrics := publisher.Metrics()
+
+		assert.Equal(t, uint64(2), metrics.Retries)
+		assert.Equal(t, uint64(1), metrics.Delayed)
+		assert.Equal(t, 0, metrics.Pending)
+	})
+
+	t.Run("rejected events are not retried", func(t *testing.T) {
+		t.Parallel()
+
+		client := &fakeBatcher{reject: "bad"}
+		publisher, err := multiverse.NewPublisher(client, conf)
+
+		require.NoError(t, err)
+		require.NoError(t, publisher.PublishGameEvent(context.Background(), "bad", []byte(`{}`)))
+		require.NoError(t, publisher.PublishGameEvent(context.Background(), "good", []byte(`{}`)))
+		require.Eventually(t, func() bool { return publisher.Metrics().Pending == 0 }, time.Second, time.Millisecond)
+		require.NoError(t, publisher.Close())
+
+		metrics := publisher.Metrics()
+
+		assert.Equal(t, uint64(1), metrics.Rejected)
+		assert.Equal(t, uint64(1), metrics.Published)
+	})
+
+	t.Run("events are dropped when the outbox is full", func(t *testing.T) {
+		t.Parallel()
+
+		limited := conf
+		limited.Capacity = 1
+
+		publisher, err := multiverse.NewPublisher(&fakeBatcher{failures: 1000}, limited)
+
+		require.NoError(t, err)
+		require.NoError(t, publisher.PublishGameEvent(context.Background(), "a", []byte(`{}`)))
+		assert.ErrorIs(t, publisher.PublishGameEvent(context.Background(), "a", []byte(`{}`)), multiverse.ErrOutboxFull)
+		require.NoError(t, publisher.Close())
+
+		// the queued event is lost with an in memory outbox
+		assert.Equal(t, uint64(2), publisher.Metrics().Dropped)
+	})
+
+	t.Run("the outbox keeps unpublished events between runs", func(t *testing.T) {
+		t.Parallel()
+
+		persisted := conf
+		persisted.Outbox = filepath.Join(t.TempDir(), "outbox.jsonl")
+
+		unavailable, err := multiverse.NewPublisher(&fakeBatcher{failures: 1000}, persisted)
+
+		require.NoError(t, err)
+		require.NoError(t, unavailable.PublishGameEvent(context.Background(), "a", []byte(`{"first":true}`)))
+		require.NoError(t, unavailable.PublishGameEvent(context.Background(), "b", []byte(`{"second":true}`)))
+		require.NoError(t, unavailable.Close())
+		assert.Equal(t, 2, unavailable.Metrics().Pending)
+
+		client := &fakeBatcher{}
+		available, err := multiverse.NewPublisher(client, persisted)
+
+		require.NoError(t, err)
+		require.Eventually(t, func() bool { return available.Metrics().Published == 2 }, time.Second, time.Millisecond)
+		require.NoError(t, available.Close())
+
+		var uids []string
+
+		for _, batch := range client.published() {
+			for _, event := range batch {
+				uids = append(uids, event.GetUid())
+			}
+		}
+
+		assert.Equal(t, []string{"a", "b"}, uids)
+	})
+}
+
+type fakeBatcher struct {
+	mu       sync.Mutex
+	failures int
+	reject   string
+	batches  [][]*proto.GameEvent
+}
+
+func (b *fakeBatcher) PublishGameEvents(_ context.Context, events []*proto.GameEvent) ([]error, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures > 0 {
+		b.failures--
+
+		return nil, errors.New("unavailable")
+	}
+
+	results := make([]error, len(events))
+
+	for idx, event := range events {
+		if event.GetUid() == b.reject {
+			results[idx] = errors.New("rejected")
+		}
+	}
+
+	b.batches = append(b.batches, events)
+
+	return results, nil
+}
+
+func (b *fakeBatcher) published() [][]*proto.GameEvent {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.batches
+}
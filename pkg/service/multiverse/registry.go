@@ -0,0 +1,305 @@
+package multiverse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ciphermountain/deadenz/pkg/components"
+)
+
+// CharacterRegistry tracks which character type each player is currently playing and which character types have
+// ever spawned. A player plays at most one character at a time.
+type CharacterRegistry struct {
+	mu         sync.RWMutex
+	players    map[components.CharacterType][]string
+	characters map[string]components.CharacterType
+	seen       map[components.CharacterType]struct{}
+}
+
+// NewCharacterRegistry creates an empty character registry.
+func NewCharacterRegistry() *CharacterRegistry {
+	return &CharacterRegistry{
+		players:    make(map[components.CharacterType][]string),
+		characters: make(map[string]components.CharacterType),
+		seen:       make(map[components.CharacterType]struct{}),
+	}
+}
+
+// FirstSpawn records that the character type spawned and indicates whether it is the first time it ever has.
+func (r *CharacterRegistry) FirstSpawn(character components.CharacterType) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.seen[character]; ok {
+		return false
+	}
+
+	r.seen[character] = struct{}{}
+
+	return true
+}
+
+// Register records the player as playing the character, replacing any character the player was playing.
+func (r *CharacterRegistry) Register(uid string, character components.CharacterType) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.unregisterLocked(uid)
+
+	r.players[character] = append(r.players[character], uid)
+	r.characters[uid] = character
+}
+
+// Unregister removes the player from the registry.
+func (r *CharacterRegistry) Unregister(uid string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.unregisterLocked(uid)
+}
+
+// Kill removes every player of the character from the registry and returns them.
+func (r *CharacterRegistry) Kill(character components.CharacterType) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	players := r.players[character]
+
+	for _, uid := range players {
+		delete(r.characters, uid)
+	}
+
+	delete(r.players, character)
+
+	return players
+}
+
+// Players returns the players currently playing the character.
+func (r *CharacterRegistry) Players(character components.CharacterType) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	players := make([]string, len(r.players[character]))
+	copy(players, r.players[character])
+
+	return players
+}
+
+// Character returns the character the player is currently playing.
+func (r *CharacterRegistry) Character(uid string) (components.CharacterType, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	character, ok := r.characters[uid]
+
+	return character, ok
+}
+
+// Len returns the number of registered players.
+func (r *CharacterRegistry) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.characters)
+}
+
+func (r *CharacterRegistry) unregisterLocked(uid string) {
+	character, ok := r.characters[uid]
+	if !ok {
+		return
+	}
+
+	players := r.players[character]
+
+	for idx, player := range players {
+		if player == uid {
+			players = append(players[:idx], players[idx+1:]...)
+
+			break
+		}
+	}
+
+	if len(players) == 0 {
+		delete(r.players, character)
+	} else {
+		r.players[character] = players
+	}
+
+	delete(r.characters, uid)
+}
+
+// registrySnapshot is the persisted form of the registry.
+type registrySnapshot struct {
+	Taken      time.Time                           `json:"taken"`
+	Characters map[string]components.CharacterType `json:"characters"`
+	Seen       []components.CharacterType          `json:"seen,omitempty"`
+}
+
+// WriteSnapshot writes the current state of the registry as JSON.
+func (r *CharacterRegistry) WriteSnapshot(w io.Writer) error {
+	r.mu.RLock()
+
+	snapshot := registrySnapshot{
+		Taken:      time.Now(),
+		Characters: make(map[string]components.CharacterType, len(r.characters)),
+	}
+
+	for uid, character := range r.characters {
+		snapshot.Characters[uid] = character
+	}
+
+	for character := range r.seen {
+		snapshot.Seen = append(snapshot.Seen, character)
+	}
+
+	r.mu.RUnlock()
+
+	sort.Slice(snapshot.Seen, func(i, j int) bool { return snapshot.Seen[i] < snapshot.Seen[j] })
+
+	return json.NewEncoder(w).Encode(snapshot)
+}
+
+// ReadSnapshot replaces the state of the registry with a snapshot written by WriteSnapshot.
+func (r *CharacterRegistry) ReadSnapshot(rd io.Reader) error {
+	var snapshot registrySnapshot
+	if err := json.NewDecoder(rd).Decode(&snapshot); err != nil {
+		return err
+	}
+
+	// sort players so restored lookups do not depend on map iteration order
+	uids := make([]string, 0, len(snapshot.Characters))
+	for uid := range snapshot.Characters {
+		uids = append(uids, uid)
+	}
+
+	sort.Strings(uids)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.players = make(map[components.CharacterType][]string)
+	r.characters = make(map[string]components.CharacterType, len(uids))
+	r.seen = make(map[components.CharacterType]struct{}, len(snapshot.Seen))
+
+	for _, uid := range uids {
+		character := snapshot.Characters[uid]
+
+		r.players[character] = append(r.players[character], uid)
+		r.characters[uid] = character
+		r.seen[character] = struct{}{}
+	}
+
+	for _, character := range snapshot.Seen {
+		r.seen[character] = struct{}{}
+	}
+
+	return nil
+}
+
+// MergeSnapshot adds the players and seen character types of a snapshot written by WriteSnapshot, such as one
+// from a peered node. Players already in the registry keep their current character.
+func (r *CharacterRegistry) MergeSnapshot(rd io.Reader) error {
+	var snapshot registrySnapshot
+	if err := json.NewDecoder(rd).Decode(&snapshot); err != nil {
+		return err
+	}
+
+	uids := make([]string, 0, len(snapshot.Characters))
+	for uid := range snapshot.Characters {
+		uids = append(uids, uid)
+	}
+
+	sort.Strings(uids)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, uid := range uids {
+		if _, ok := r.characters[uid]; ok {
+			continue
+		}
+
+		character := snapshot.Characters[uid]
+
+		r.players[character] = append(r.players[character], uid)
+		r.characters[uid] = character
+		r.seen[character] = struct{}{}
+	}
+
+	for _, character := range snapshot.Seen {
+		r.seen[character] = struct{}{}
+	}
+
+	return nil
+}
+
+// SaveSnapshot writes a snapshot of the registry to the file path. The file is replaced atomically so that a
+// failed write never corrupts the previous snapshot.
+func (r *CharacterRegistry) SaveSnapshot(path string) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	if err := r.WriteSnapshot(tmp); err != nil {
+		tmp.Close()
+
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// LoadSnapshot restores the registry from the snapshot at the file path. A missing file leaves the registry
+// empty.
+func (r *CharacterRegistry) LoadSnapshot(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+
+		return err
+	}
+
+	defer file.Close()
+
+	return r.ReadSnapshot(file)
+}
+
+// RunSnapshots saves a snapshot of the registry to the file path on every interval until the context is
+// cancelled, at which point a final snapshot is saved.
+func (r *CharacterRegistry) RunSnapshots(ctx context.Context, path string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if err := r.SaveSnapshot(path); err != nil {
+				log.Printf("failed to save final character registry snapshot: %s", err.Error())
+			}
+
+			return
+		case <-ticker.C:
+			if err := r.SaveSnapshot(path); err != nil {
+				log.Printf("failed to save character registry snapshot: %s", err.Error())
+			}
+		}
+	}
+}
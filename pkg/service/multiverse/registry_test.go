@@ -0,0 +1,106 @@
+package multiverse_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ciphermountain/deadenz/pkg/service/multiverse"
+)
+
+func TestCharacterRegistry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("registering moves a player to the new character", func(t *testing.T) {
+		t.Parallel()
+
+		registry := multiverse.NewCharacterRegistry()
+
+		registry.Register("a", 1)
+		registry.Register("b", 1)
+		registry.Register("a", 2)
+
+		character, ok := registry.Character("a")
+
+		require.True(t, ok)
+		assert.Equal(t, 2, int(character))
+		assert.Equal(t, []string{"b"}, registry.Players(1))
+		assert.Equal(t, []string{"a"}, registry.Players(2))
+	})
+
+	t.Run("killing a character removes every player of it", func(t *testing.T) {
+		t.Parallel()
+
+		registry := multiverse.NewCharacterRegistry()
+
+		registry.Register("a", 1)
+		registry.Register("b", 1)
+		registry.Register("c", 2)
+
+		assert.ElementsMatch(t, []string{"a", "b"}, registry.Kill(1))
+		assert.Equal(t, 1, registry.Len())
+
+		_, ok := registry.Character("a")
+
+		assert.False(t, ok)
+	})
+
+	t.Run("snapshots restore living characters", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "characters.json")
+		registry := multiverse.NewCharacterRegistry()
+
+		registry.Register("a", 1)
+		registry.Register("b", 3)
+		registry.FirstSpawn(5)
+
+		require.NoError(t, registry.SaveSnapshot(path))
+
+		restored := multiverse.NewCharacterRegistry()
+
+		require.NoError(t, restored.LoadSnapshot(path))
+		assert.Equal(t, 2, restored.Len())
+		assert.Equal(t, []string{"b"}, restored.Players(3))
+		assert.False(t, restored.FirstSpawn(5), "spawned character types should be restored")
+		assert.True(t, restored.FirstSpawn(6))
+	})
+
+	t.Run("missing snapshot leaves registry empty", func(t *testing.T) {
+		t.Parallel()
+
+		registry := multiverse.NewCharacterRegistry()
+
+		require.NoError(t, registry.LoadSnapshot(filepath.Join(t.TempDir(), "missing.json")))
+		assert.Equal(t, 0, registry.Len())
+	})
+
+	t.Run("merging a snapshot keeps current characters", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		peer := multiverse.NewCharacterRegistry()
+
+		peer.Register("a", 1)
+		peer.Register("b", 2)
+		peer.FirstSpawn(7)
+
+		require.NoError(t, peer.WriteSnapshot(&buf))
+
+		registry := multiverse.NewCharacterRegistry()
+
+		registry.Register("a", 3)
+
+		require.NoError(t, registry.MergeSnapshot(&buf))
+
+		character, _ := registry.Character("a")
+
+		assert.Equal(t, 3, int(character))
+		assert.Equal(t, []string{"b"}, registry.Players(2))
+		assert.False(t, registry.FirstSpawn(7))
+	})
+}
@@ -1,9 +1,13 @@
 package multiverse
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"log"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ciphermountain/deadenz/pkg/components"
@@ -13,41 +17,137 @@ import (
 )
 
 var (
-	ErrUnimplemented = errors.New("unimplemented")
+	ErrUnimplemented     = errors.New("unimplemented")
+	ErrMismatchedEventID = errors.New("relayed game event id does not match the change id")
+	ErrMissingUID        = errors.New("player uid required")
+	ErrEmptyAnnouncement = errors.New("announcement message required")
+	ErrNotSynced         = errors.New("character registry not synced from a peer")
 )
 
 var _ proto.MultiverseServer = &MultiverseServer{}
 
 type MultiverseServer struct {
 	proto.UnimplementedMultiverseServer
-	subscribers      map[string]chan *proto.Event
-	characterLookup  map[components.CharacterType][]string
-	characterReverse map[string]components.CharacterType
-	characterLocks   map[components.CharacterType]*sync.RWMutex
-	mu               sync.RWMutex
+	characters *CharacterRegistry
+	retention  time.Duration
+	federation *federation
+	verifier   *Verifier
+	node       string
+	peers      []Peer
+	syncers    []Syncer
+	synced     atomic.Bool
+	// peerIdentities are the client certificate names allowed to relay, sync and announce
+	peerIdentities map[string]struct{}
+	// insecurePeers accepts peer calls from any caller
+	insecurePeers bool
+	mu            sync.RWMutex
+	// logs holds the events routed to each player, including players that are briefly disconnected
+	logs map[string]*eventLog
+	// streams counts the open streams of each player so that a player can subscribe from several clients
+	streams map[string]int
 }
 
-func NewMultiverseServer() *MultiverseServer {
-	return &MultiverseServer{
-		subscribers: make(map[string]chan *proto.Event),
+// ServerOpt configures optional behaviour of a multiverse server.
+type ServerOpt func(*MultiverseServer)
+
+// WithCharacterRegistry uses the provided registry, such as one restored from a snapshot, to track living
+// characters.
+func WithCharacterRegistry(registry *CharacterRegistry) ServerOpt {
+	return func(s *MultiverseServer) {
+		s.characters = registry
+	}
+}
+
+// WithNodeID identifies the node to its peers. A random id is used when none is provided.
+func WithNodeID(id string) ServerOpt {
+	return func(s *MultiverseServer) {
+		s.node = id
+	}
+}
+
+// WithPeers relays every accepted change to the provided peered nodes so that the nodes form one universe.
+func WithPeers(peers ...Peer) ServerOpt {
+	return func(s *MultiverseServer) {
+		s.peers = append(s.peers, peers...)
+	}
+}
+
+// WithRegistrySync copies the character registry from the first of the syncers that responds before the node
+// reports ready so that a node joining a universe does not serve players an incomplete registry.
+func WithRegistrySync(syncers ...Syncer) ServerOpt {
+	return func(s *MultiverseServer) {
+		s.syncers = append(s.syncers, syncers...)
+	}
+}
+
+// WithVerifier only accepts game events, registrations and deregistrations that are signed and not replayed. The
+// signature made by the core server travels with a change relayed by a peer and is verified again by every node.
+func WithVerifier(verifier *Verifier) ServerOpt {
+	return func(s *MultiverseServer) {
+		s.verifier = verifier
+	}
+}
+
+// WithRetention keeps events for players to resume from for the provided window.
+func WithRetention(window time.Duration) ServerOpt {
+	return func(s *MultiverseServer) {
+		s.retention = window
 	}
 }
 
+func NewMultiverseServer(opts ...ServerOpt) *MultiverseServer {
+	server := &MultiverseServer{
+		characters: NewCharacterRegistry(),
+		retention:  DefaultRetention,
+		logs:       make(map[string]*eventLog),
+		streams:    make(map[string]int),
+	}
+
+	for _, opt := range opts {
+		opt(server)
+	}
+
+	server.federation = newFederation(server.node, server.peers)
+
+	return server
+}
+
+// PublishGameEvent applies a game event published by a core server and relays it to every peer.
 func (s *MultiverseServer) PublishGameEvent(ctx context.Context, event *proto.GameEvent) (*proto.Response, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+	if err := s.verify(event, time.Now()); err != nil {
+		log.Printf("rejected game event for %s: %s", event.GetUid(), err.Error())
 
-	// unmarshal game event
-	evt, err := parse.DecodeJSONEvent(event.Data)
-	if err != nil {
+		return &proto.Response{
+			Status:  proto.Status_Failure,
+			Message: err.Error(),
+		}, nil
+	}
+
+	// decode before accepting so that an invalid event is rejected rather than relayed
+	if _, err := parse.DecodeJSONEvent(event.Data); err != nil {
 		return nil, err
 	}
 
-	switch typed := evt.(type) {
-	case events.CharacterSpawnEvent:
-		s.saveSpawnEvent(typed, event.Uid)
-	case events.DieMutationEventWithCharacter:
-		s.processDeathEvent(typed)
+	id := event.GetId()
+	if id == "" {
+		id = s.federation.newID()
+	}
+
+	if err := s.accept(false, &proto.Relayed{
+		Id:     id,
+		Origin: s.federation.node,
+		Change: &proto.Relayed_GameEvent{
+			GameEvent: &proto.GameEvent{
+				Uid:       event.GetUid(),
+				Data:      event.GetData(),
+				Id:        id,
+				KeyId:     event.GetKeyId(),
+				Timestamp: event.GetTimestamp(),
+				Signature: event.GetSignature(),
+			},
+		},
+	}); err != nil {
+		return nil, err
 	}
 
 	return &proto.Response{
@@ -55,99 +155,563 @@ func (s *MultiverseServer) PublishGameEvent(ctx context.Context, event *proto.Ga
 	}, nil
 }
 
+// PublishGameEvents applies each event of the batch in order. An event that is rejected does not prevent the
+// remaining events from being applied.
+func (s *MultiverseServer) PublishGameEvents(ctx context.Context, batch *proto.GameEventBatch) (*proto.BatchResponse, error) {
+	responses := make([]*proto.Response, len(batch.GetEvents()))
+
+	for idx, event := range batch.GetEvents() {
+		resp, err := s.PublishGameEvent(ctx, event)
+		if err != nil {
+			resp = &proto.Response{
+				Status:  proto.Status_Failure,
+				Message: err.Error(),
+			}
+		}
+
+		responses[idx] = resp
+	}
+
+	return &proto.BatchResponse{Responses: responses}, nil
+}
+
+// Register records the character a player is playing.
+func (s *MultiverseServer) Register(_ context.Context, reg *proto.Registration) (*proto.Response, error) {
+	if reg.GetUid() == "" {
+		return &proto.Response{
+			Status:  proto.Status_Failure,
+			Message: ErrMissingUID.Error(),
+		}, nil
+	}
+
+	if err := s.verify(reg, time.Now()); err != nil {
+		log.Printf("rejected registration for %s: %s", reg.GetUid(), err.Error())
+
+		return &proto.Response{
+			Status:  proto.Status_Failure,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return s.acceptLocal(&proto.Relayed{
+		Change: &proto.Relayed_Registration{Registration: reg},
+	})
+}
+
+// Deregister removes a player whose character is no longer alive.
+func (s *MultiverseServer) Deregister(_ context.Context, dereg *proto.Deregistration) (*proto.Response, error) {
+	if dereg.GetUid() == "" {
+		return &proto.Response{
+			Status:  proto.Status_Failure,
+			Message: ErrMissingUID.Error(),
+		}, nil
+	}
+
+	if err := s.verify(dereg, time.Now()); err != nil {
+		log.Printf("rejected deregistration for %s: %s", dereg.GetUid(), err.Error())
+
+		return &proto.Response{
+			Status:  proto.Status_Failure,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return s.acceptLocal(&proto.Relayed{
+		Change: &proto.Relayed_Deregistration{Deregistration: dereg},
+	})
+}
+
+// Announce broadcasts a server announcement to every player. Only configured peers may announce.
+func (s *MultiverseServer) Announce(ctx context.Context, announcement *proto.Announcement) (*proto.Response, error) {
+	if err := s.authorizePeer(ctx); err != nil {
+		return nil, err
+	}
+
+	if announcement.GetMessage() == "" {
+		return &proto.Response{
+			Status:  proto.Status_Failure,
+			Message: ErrEmptyAnnouncement.Error(),
+		}, nil
+	}
+
+	return s.acceptLocal(&proto.Relayed{
+		Change: &proto.Relayed_Announcement{Announcement: announcement},
+	})
+}
+
+// Relay applies a change accepted by a peered node. A change that was already applied is acknowledged without
+// being applied again. Only configured peers may relay changes and the signature of a relayed game event,
+// registration or deregistration is verified before it is applied.
+func (s *MultiverseServer) Relay(ctx context.Context, change *proto.Relayed) (*proto.Response, error) {
+	if err := s.authorizePeer(ctx); err != nil {
+		return nil, err
+	}
+
+	if change.GetId() == "" {
+		return &proto.Response{
+			Status:  proto.Status_Failure,
+			Message: ErrMissingChangeID.Error(),
+		}, nil
+	}
+
+	if err := s.accept(true, change); err != nil {
+		log.Printf("rejected relayed change %s from %s: %s", change.GetId(), change.GetOrigin(), err.Error())
+
+		return &proto.Response{
+			Status:  proto.Status_Failure,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &proto.Response{
+		Status: proto.Status_OK,
+	}, nil
+}
+
+// Sync returns a snapshot of the character registry for a joining node. Only configured peers may sync.
+func (s *MultiverseServer) Sync(ctx context.Context, req *proto.SyncRequest) (*proto.RegistrySnapshot, error) {
+	if err := s.authorizePeer(ctx); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	if err := s.characters.WriteSnapshot(&buf); err != nil {
+		return nil, err
+	}
+
+	log.Printf("sending character registry to node %s", req.GetNode())
+
+	return &proto.RegistrySnapshot{Data: buf.Bytes()}, nil
+}
+
+// Ready returns an error until the character registry has been synced from a peer. A node without syncers is
+// ready as soon as it is created with its restored registry. A failed sync is retried on every check.
+func (s *MultiverseServer) Ready(ctx context.Context) error {
+	if len(s.syncers) == 0 || s.synced.Load() {
+		return nil
+	}
+
+	var errs []error
+
+	for _, syncer := range s.syncers {
+		data, err := syncer.Sync(ctx, s.federation.node)
+		if err == nil {
+			err = s.characters.MergeSnapshot(bytes.NewReader(data))
+		}
+
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		log.Printf("synced %d living characters from a peer", s.characters.Len())
+		s.synced.Store(true)
+
+		return nil
+	}
+
+	return fmt.Errorf("%w: %w", ErrNotSynced, errors.Join(errs...))
+}
+
+// Close stops relaying changes to peers.
+func (s *MultiverseServer) Close() error {
+	s.federation.close()
+
+	return nil
+}
+
+// Events streams the events routed to the subscribing player that match the filter in sequence order. Each
+// stream is independent of any other stream opened by the same player. A stream resuming from a sequence first
+// receives every retained event it missed.
 func (s *MultiverseServer) Events(filter *proto.Filter, stream proto.Multiverse_EventsServer) error {
-	chEvents := make(chan *proto.Event, 100)
+	sub := newSubscription(filter)
 
-	s.mu.Lock()
-	s.subscribers[filter.Uid] = chEvents
-	s.mu.Unlock()
+	playerLog := s.subscribe(filter.Uid)
+	defer s.unsubscribe(filter.Uid)
+
+	cursor := filter.GetResumeFrom()
+	if cursor == 0 {
+		cursor = playerLog.head()
+	}
 
 	for {
-		select {
-		case <-stream.Context().Done():
-			s.mu.Lock()
-			delete(s.subscribers, filter.Uid)
-			s.mu.Unlock()
+		entries, wait := playerLog.since(cursor)
 
-			return nil
-		case evt := <-chEvents:
-			if err := stream.Send(evt); err != nil {
+		for _, entry := range entries {
+			cursor = entry.sequence + 1
+
+			if !sub.matches(entry.topic) {
+				continue
+			}
+
+			if err := stream.Send(entry.event); err != nil {
 				return err
 			}
 		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-wait:
+		}
 	}
 }
 
-func (s *MultiverseServer) saveSpawnEvent(evt events.CharacterSpawnEvent, id string) {
-	lock := mustGetCharacterLock(evt.Type(), s.characterLocks)
+// Prune drops events and relayed change ids older than the retention window and forgets players that have no
+// retained events, no open streams and no living character.
+func (s *MultiverseServer) Prune(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	lock.Lock()
-	s.characterLookup[evt.Type()] = mustAddValue(s.characterLookup[evt.Type()], id)
-	lock.Unlock()
+	s.federation.prune(now, s.retention)
 
-	s.mu.Lock()
-	s.characterReverse[id] = evt.Type()
-	s.mu.Unlock()
+	for uid, playerLog := range s.logs {
+		if !playerLog.prune(now) || s.streams[uid] > 0 {
+			continue
+		}
+
+		if _, alive := s.characters.Character(uid); !alive {
+			delete(s.logs, uid)
+		}
+	}
 }
 
-func (s *MultiverseServer) processDeathEvent(evt events.DieMutationEventWithCharacter) {
-	character := evt.Character
-	lock := mustGetCharacterLock(character, s.characterLocks)
+// RunPruning prunes retained events on every interval until the context is cancelled.
+func (s *MultiverseServer) RunPruning(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.Prune(now)
+		}
+	}
+}
+
+// acceptLocal accepts a change made on this node under a new id.
+func (s *MultiverseServer) acceptLocal(change *proto.Relayed) (*proto.Response, error) {
+	change.Id = s.federation.newID()
+	change.Origin = s.federation.node
+
+	if err := s.accept(false, change); err != nil {
+		return &proto.Response{
+			Status:  proto.Status_Failure,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &proto.Response{
+		Status: proto.Status_OK,
+	}, nil
+}
+
+// accept applies a change the first time it is seen and forwards it to every peer so that peers that are not
+// directly connected to the origin also receive it. A relayed change is verified once it is known to be new so
+// that the same change arriving from several peers is not rejected as a replay.
+func (s *MultiverseServer) accept(relayed bool, change *proto.Relayed) error {
+	now := time.Now()
+
+	if !s.federation.markSeen(change.GetId(), now) {
+		return nil
+	}
+
+	if relayed {
+		if err := s.verifyRelayed(change, now); err != nil {
+			return err
+		}
+	}
+
+	if err := s.apply(change); err != nil {
+		return err
+	}
+
+	s.federation.forward(change)
+
+	return nil
+}
+
+// verify checks the signature of a message from a core server when the node requires authentication.
+func (s *MultiverseServer) verify(msg Signed, now time.Time) error {
+	if s.verifier == nil {
+		return nil
+	}
+
+	return s.verifier.Verify(msg, now)
+}
+
+// verifyRelayed checks the signature the core server made for a relayed change. Announcements are not signed and
+// are trusted as they only come from configured peers.
+func (s *MultiverseServer) verifyRelayed(change *proto.Relayed, now time.Time) error {
+	switch typed := change.GetChange().(type) {
+	case *proto.Relayed_GameEvent:
+		// a signed event is relayed under its own id so that it cannot be applied again under another id
+		if s.verifier != nil && typed.GameEvent.GetId() != change.GetId() {
+			return ErrMismatchedEventID
+		}
+
+		return s.verify(typed.GameEvent, now)
+	case *proto.Relayed_Registration:
+		return s.verify(typed.Registration, now)
+	case *proto.Relayed_Deregistration:
+		return s.verify(typed.Deregistration, now)
+	default:
+		return nil
+	}
+}
+
+func (s *MultiverseServer) apply(change *proto.Relayed) error {
+	switch typed := change.GetChange().(type) {
+	case *proto.Relayed_GameEvent:
+		return s.applyGameEvent(typed.GameEvent)
+	case *proto.Relayed_Registration:
+		s.characters.Register(typed.Registration.GetUid(), components.CharacterType(typed.Registration.GetCharacter()))
+		s.track(typed.Registration.GetUid())
+	case *proto.Relayed_Deregistration:
+		s.characters.Unregister(typed.Deregistration.GetUid())
+	case *proto.Relayed_Announcement:
+		s.route(&proto.Event{
+			Type: &proto.Event_Announcement{
+				Announcement: &proto.Announcement{
+					Message: typed.Announcement.GetMessage(),
+				},
+			},
+		}, "", nil, everyone)
+	default:
+		return ErrMissingChange
+	}
+
+	return nil
+}
+
+func (s *MultiverseServer) applyGameEvent(event *proto.GameEvent) error {
+	// unmarshal game event
+	evt, err := parse.DecodeJSONEvent(event.Data)
+	if err != nil {
+		return err
+	}
+
+	switch typed := evt.(type) {
+	case events.CharacterSpawnEvent:
+		s.saveSpawnEvent(typed, event.Uid)
+	case events.DieMutationEventWithCharacter:
+		s.processDeathEvent(typed, event.Uid)
+	case events.CraftEvent:
+		s.processCraftEvent(typed, event.Uid)
+	case events.FindEvent:
+		s.processFindEvent(typed, event.Uid)
+	case events.LevelUpEvent:
+		s.processLevelUpEvent(typed, event.Uid)
+	case events.XPMilestoneEvent:
+		s.processMilestoneEvent(typed, event.Uid)
+	}
+
+	return nil
+}
+
+func (s *MultiverseServer) saveSpawnEvent(evt events.CharacterSpawnEvent, id string) {
+	s.characters.Register(id, evt.Type())
+	s.track(id)
+
+	if !s.characters.FirstSpawn(evt.Type()) {
+		return
+	}
 
-	lock.Lock()
-	recipients := s.characterLookup[character]
+	character := evt.Type()
 
-	delete(s.characterLookup, character)
-	lock.Unlock()
+	s.route(&proto.Event{
+		Type: &proto.Event_FirstSpawn{
+			FirstSpawn: &proto.FirstSpawn{
+				Uid:       id,
+				Character: uint64(character),
+				Name:      evt.Name(),
+			},
+		},
+	}, id, &character, everyoneExcept(id))
+}
+
+func (s *MultiverseServer) processDeathEvent(evt events.DieMutationEventWithCharacter, id string) {
+	character := evt.Character
+	recipients := s.characters.Kill(character)
 
 	event := &proto.Event{
 		Type: &proto.Event_CharacterDeath{
 			CharacterDeath: &proto.DeathByCharacterType{
-				Type: uint64(character),
+				Type:    uint64(character),
+				Message: evt.Death.String(),
+				Uid:     id,
 			},
 		},
 	}
 
-	for _, recipient := range recipients {
-		s.mu.RLock()
-		chEvt, ok := s.subscribers[recipient]
-		s.mu.RUnlock()
+	s.route(event, id, &character, only(recipients))
+}
 
-		if !ok {
-			continue
+func (s *MultiverseServer) processCraftEvent(evt events.CraftEvent, id string) {
+	event := &proto.Event{
+		Type: &proto.Event_ItemCrafted{
+			ItemCrafted: &proto.ItemCrafted{
+				Recipe:   uint64(evt.Recipe),
+				Item:     uint64(evt.Item.Type),
+				Name:     evt.Item.Name,
+				Quantity: evt.Quantity,
+				Uid:      id,
+			},
+		},
+	}
+
+	s.route(event, id, s.characterOf(id), everyone)
+}
+
+func (s *MultiverseServer) processFindEvent(evt events.FindEvent, id string) {
+	if !evt.Item.Rare {
+		return
+	}
+
+	s.route(&proto.Event{
+		Type: &proto.Event_RareItemFound{
+			RareItemFound: &proto.RareItemFound{
+				Uid:  id,
+				Item: uint64(evt.Item.Type),
+				Name: evt.Item.Name,
+			},
+		},
+	}, id, s.characterOf(id), everyoneExcept(id))
+}
+
+func (s *MultiverseServer) processLevelUpEvent(evt events.LevelUpEvent, id string) {
+	// level ups are only shared with players of the same character type
+	character, ok := s.characters.Character(id)
+	if !ok {
+		return
+	}
+
+	var kin []string
+
+	for _, player := range s.characters.Players(character) {
+		if player != id {
+			kin = append(kin, player)
 		}
+	}
+
+	s.route(&proto.Event{
+		Type: &proto.Event_LevelUp{
+			LevelUp: &proto.LevelUp{
+				Uid:       id,
+				Character: uint64(character),
+				Level:     uint32(evt.Level),
+			},
+		},
+	}, id, &character, only(kin))
+}
+
+func (s *MultiverseServer) processMilestoneEvent(evt events.XPMilestoneEvent, id string) {
+	s.route(&proto.Event{
+		Type: &proto.Event_XpMilestone{
+			XpMilestone: &proto.XPMilestone{
+				Uid: id,
+				Xp:  uint64(evt.XP),
+			},
+		},
+	}, id, s.characterOf(id), everyoneExcept(id))
+}
 
-		go sendToChannel(chEvt, event)
+// characterOf returns the character the player is currently playing or nil if the player is not registered.
+func (s *MultiverseServer) characterOf(id string) *components.CharacterType {
+	character, ok := s.characters.Character(id)
+	if !ok {
+		return nil
 	}
+
+	return &character
 }
 
-func mustAddValue(values []string, toAdd string) []string {
-	for _, val := range values {
-		if val == toAdd {
-			return values
+// route appends the event to the log of every player in the audience. The player is the one that caused the
+// event and character is what the event is about, if anything. Subscriber filters are applied as the logs are
+// read.
+func (s *MultiverseServer) route(event *proto.Event, player string, character *components.CharacterType, audience func(string) bool) {
+	t := topic{
+		kind:      eventType(event),
+		player:    player,
+		character: character,
+	}
+
+	now := time.Now()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for uid, playerLog := range s.logs {
+		if audience(uid) {
+			playerLog.append(event, t, now)
 		}
 	}
+}
+
+// track starts retaining events for the player.
+func (s *MultiverseServer) track(uid string) *eventLog {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	return append(values, toAdd)
+	return s.trackLocked(uid)
 }
 
-func mustGetCharacterLock(character components.CharacterType, locks map[components.CharacterType]*sync.RWMutex) *sync.RWMutex {
-	lock, ok := locks[character]
+func (s *MultiverseServer) trackLocked(uid string) *eventLog {
+	playerLog, ok := s.logs[uid]
 	if !ok {
-		lock = &sync.RWMutex{}
-		locks[character] = lock
+		playerLog = newEventLog(s.retention)
+		s.logs[uid] = playerLog
 	}
 
-	return lock
+	return playerLog
 }
 
-func sendToChannel(chEvts chan *proto.Event, evt *proto.Event) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+func (s *MultiverseServer) subscribe(uid string) *eventLog {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.streams[uid]++
+
+	return s.trackLocked(uid)
+}
+
+// unsubscribe closes one stream of the player. Events remain retained for the player to resume from.
+func (s *MultiverseServer) unsubscribe(uid string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.streams[uid]--; s.streams[uid] <= 0 {
+		delete(s.streams, uid)
+	}
+}
+
+// everyone includes every subscriber in the audience of an event.
+func everyone(string) bool {
+	return true
+}
+
+// everyoneExcept includes every subscriber other than the excluded player in the audience of an event.
+func everyoneExcept(exclude string) func(string) bool {
+	return func(uid string) bool {
+		return uid != exclude
+	}
+}
+
+// only includes the listed players in the audience of an event.
+func only(recipients []string) func(string) bool {
+	set := make(map[string]struct{}, len(recipients))
+
+	for _, uid := range recipients {
+		set[uid] = struct{}{}
+	}
+
+	return func(uid string) bool {
+		_, ok := set[uid]
 
-	select {
-	case chEvts <- evt:
-		cancel()
-	case <-ctx.Done():
-		cancel()
+		return ok
 	}
 }
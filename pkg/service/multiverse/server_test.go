@@ -0,0 +1,260 @@
+package multiverse
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/ciphermountain/deadenz/pkg/components"
+	proto "github.com/ciphermountain/deadenz/pkg/proto/multiverse"
+)
+
+func TestMultiverseServer_Events(t *testing.T) {
+	t.Parallel()
+
+	t.Run("streams of the same player are independent", func(t *testing.T) {
+		t.Parallel()
+
+		server := NewMultiverseServer(WithPeerIdentities(testPeer))
+
+		first, closeFirst := newFakeStream()
+		second, closeSecond := newFakeStream()
+
+		defer closeSecond()
+
+		firstDone := make(chan error, 1)
+		secondDone := make(chan error, 1)
+
+		go func() { firstDone <- server.Events(&proto.Filter{Uid: "a"}, first) }()
+		go func() { secondDone <- server.Events(&proto.Filter{Uid: "a"}, second) }()
+
+		require.Eventually(t, func() bool { return server.subscriptions("a") == 2 }, time.Second, 10*time.Millisecond)
+
+		announce(t, server, "both")
+
+		assert.Equal(t, "both", (<-first.sent).GetAnnouncement().GetMessage())
+		assert.Equal(t, "both", (<-second.sent).GetAnnouncement().GetMessage())
+
+		closeFirst()
+		require.NoError(t, <-firstDone)
+		assert.Equal(t, 1, server.subscriptions("a"))
+
+		announce(t, server, "second")
+
+		assert.Equal(t, "second", (<-second.sent).GetAnnouncement().GetMessage())
+	})
+
+	t.Run("closing the last stream retains events for the player", func(t *testing.T) {
+		t.Parallel()
+
+		server := NewMultiverseServer(WithPeerIdentities(testPeer))
+		stream, closeStream := newFakeStream()
+		done := make(chan error, 1)
+
+		go func() { done <- server.Events(&proto.Filter{Uid: "a"}, stream) }()
+
+		require.Eventually(t, func() bool { return server.subscriptions("a") == 1 }, time.Second, 10*time.Millisecond)
+
+		announce(t, server, "connected")
+
+		first := <-stream.sent
+
+		closeStream()
+		require.NoError(t, <-done)
+		assert.Equal(t, 0, server.subscriptions("a"))
+
+		announce(t, server, "missed")
+		announce(t, server, "also missed")
+
+		resumed, closeResumed := newFakeStream()
+		defer closeResumed()
+
+		go func() {
+			_ = server.Events(&proto.Filter{Uid: "a", ResumeFrom: first.GetSequence() + 1}, resumed)
+		}()
+
+		missed := <-resumed.sent
+		alsoMissed := <-resumed.sent
+
+		assert.Equal(t, "missed", missed.GetAnnouncement().GetMessage())
+		assert.Equal(t, first.GetSequence()+1, missed.GetSequence())
+		assert.Equal(t, "also missed", alsoMissed.GetAnnouncement().GetMessage())
+		assert.Equal(t, first.GetSequence()+2, alsoMissed.GetSequence())
+	})
+
+	t.Run("slow streams receive every event in order", func(t *testing.T) {
+		t.Parallel()
+
+		server := NewMultiverseServer(WithPeerIdentities(testPeer))
+		server.track("a")
+
+		for idx := 0; idx < 250; idx++ {
+			announce(t, server, "event")
+		}
+
+		stream, closeStream := newFakeStream()
+		defer closeStream()
+
+		go func() { _ = server.Events(&proto.Filter{Uid: "a", ResumeFrom: 1}, stream) }()
+
+		for idx := 0; idx < 250; idx++ {
+			assert.Equal(t, uint64(idx+1), (<-stream.sent).GetSequence())
+		}
+	})
+
+	t.Run("pruning forgets expired events of departed players", func(t *testing.T) {
+		t.Parallel()
+
+		server := NewMultiverseServer(WithRetention(time.Minute), WithPeerIdentities(testPeer))
+		server.track("a")
+		server.track("b")
+
+		_, err := server.Register(context.Background(), &proto.Registration{Uid: "b", Character: 1})
+		require.NoError(t, err)
+
+		announce(t, server, "old")
+
+		server.Prune(time.Now())
+
+		server.mu.RLock()
+		assert.Len(t, server.logs, 2)
+		server.mu.RUnlock()
+
+		server.Prune(time.Now().Add(2 * time.Minute))
+
+		server.mu.RLock()
+		defer server.mu.RUnlock()
+
+		_, forgotten := server.logs["a"]
+		_, registered := server.logs["b"]
+
+		assert.False(t, forgotten)
+		assert.True(t, registered)
+	})
+}
+
+func TestMultiverseServer_Ready(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		server := NewMultiverseServer(WithRegistrySync(&fakeSyncer{err: errors.New("unavailable")}))
+		defer server.Close()
+
+		assert.ErrorIs(t, server.Ready(context.Background()), ErrNotSynced)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("a node without peers is ready", func(t *testing.T) {
+			t.Parallel()
+
+			server := NewMultiverseServer()
+			defer server.Close()
+
+			assert.NoError(t, server.Ready(context.Background()))
+		})
+
+		t.Run("a node is ready once a peer responds", func(t *testing.T) {
+			t.Parallel()
+
+			peered := NewCharacterRegistry()
+			peered.Register("a", 4)
+
+			var snapshot bytes.Buffer
+			require.NoError(t, peered.WriteSnapshot(&snapshot))
+
+			down := &fakeSyncer{err: errors.New("unavailable")}
+			server := NewMultiverseServer(WithRegistrySync(down, &fakeSyncer{}))
+			defer server.Close()
+
+			require.ErrorIs(t, server.Ready(context.Background()), ErrNotSynced)
+
+			// the peer that was down comes back with its registry
+			down.set(snapshot.Bytes(), nil)
+
+			require.NoError(t, server.Ready(context.Background()))
+
+			character, ok := server.characters.Character("a")
+
+			assert.True(t, ok)
+			assert.Equal(t, components.CharacterType(4), character)
+		})
+	})
+}
+
+type fakeSyncer struct {
+	mu   sync.Mutex
+	data []byte
+	err  error
+}
+
+func (s *fakeSyncer) set(data []byte, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.data, s.err = data, err
+}
+
+func (s *fakeSyncer) Sync(_ context.Context, _ string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	if s.data == nil {
+		return nil, errors.New("no registry")
+	}
+
+	return s.data, nil
+}
+
+func (s *MultiverseServer) subscriptions(uid string) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.streams[uid]
+}
+
+func announce(t *testing.T, server *MultiverseServer, message string) {
+	t.Helper()
+
+	resp, err := server.Announce(peerContext(context.Background(), testPeer), &proto.Announcement{Message: message})
+
+	require.NoError(t, err)
+	require.Equal(t, proto.Status_OK, resp.GetStatus())
+}
+
+type fakeStream struct {
+	grpc.ServerStream
+
+	ctx  context.Context
+	sent chan *proto.Event
+}
+
+func newFakeStream() (*fakeStream, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &fakeStream{ctx: ctx, sent: make(chan *proto.Event, 300)}, cancel
+}
+
+func (s *fakeStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeStream) Send(evt *proto.Event) error {
+	s.sent <- evt
+
+	return nil
+}
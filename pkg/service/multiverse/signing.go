@@ -0,0 +1,208 @@
+package multiverse
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"os"
+	"sync"
+	"time"
+
+	proto "github.com/ciphermountain/deadenz/pkg/proto/multiverse"
+)
+
+// DefaultSignatureWindow is how far the timestamp of a signed message may be from the time it is verified.
+const DefaultSignatureWindow = time.Minute
+
+var (
+	ErrUnsignedEvent    = errors.New("message is not signed")
+	ErrUnknownKey       = errors.New("message signed with unknown key")
+	ErrInvalidSignature = errors.New("message signature is invalid")
+	ErrStaleEvent       = errors.New("message timestamp is outside the signature window")
+	ErrReplayedEvent    = errors.New("message was already published")
+)
+
+// Signed is a message a core server signs with a shared key: a game event, registration or deregistration.
+type Signed interface {
+	GetId() string
+	GetKeyId() string
+	GetTimestamp() int64
+	GetSignature() []byte
+}
+
+// Keys are the shared secrets publishers sign messages with by key id.
+type Keys map[string][]byte
+
+// LoadKeys reads shared keys from a JSON file of key ids to hex encoded secrets.
+func LoadKeys(path string) (Keys, error) {
+	bts, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var encoded map[string]string
+	if err := json.Unmarshal(bts, &encoded); err != nil {
+		return nil, err
+	}
+
+	keys := make(Keys, len(encoded))
+
+	for id, secret := range encoded {
+		if keys[id], err = hex.DecodeString(secret); err != nil {
+			return nil, fmt.Errorf("key %s: %w", id, err)
+		}
+	}
+
+	return keys, nil
+}
+
+// Signer signs messages with a single shared key before they are sent to the multiverse.
+type Signer struct {
+	keyID  string
+	secret []byte
+}
+
+// NewSigner creates a signer for the shared key.
+func NewSigner(keyID string, secret []byte) *Signer {
+	return &Signer{
+		keyID:  keyID,
+		secret: secret,
+	}
+}
+
+// Sign sets the key id, timestamp and signature of the message. A message without an id is given a random one.
+func (s *Signer) Sign(msg Signed, now time.Time) {
+	id := msg.GetId()
+	if id == "" {
+		id = randomID()
+	}
+
+	switch typed := msg.(type) {
+	case *proto.GameEvent:
+		typed.Id, typed.KeyId, typed.Timestamp = id, s.keyID, now.UnixMilli()
+		typed.Signature = signature(s.secret, typed)
+	case *proto.Registration:
+		typed.Id, typed.KeyId, typed.Timestamp = id, s.keyID, now.UnixMilli()
+		typed.Signature = signature(s.secret, typed)
+	case *proto.Deregistration:
+		typed.Id, typed.KeyId, typed.Timestamp = id, s.keyID, now.UnixMilli()
+		typed.Signature = signature(s.secret, typed)
+	}
+}
+
+// Verifier authenticates signed messages and rejects any message received more than once within the signature
+// window.
+type Verifier struct {
+	keys   Keys
+	window time.Duration
+
+	mu     sync.Mutex
+	seen   map[string]time.Time
+	pruned time.Time
+}
+
+// NewVerifier creates a verifier that accepts events signed with any of the keys.
+func NewVerifier(keys Keys, window time.Duration) *Verifier {
+	return &Verifier{
+		keys:   keys,
+		window: window,
+		seen:   make(map[string]time.Time),
+	}
+}
+
+// Verify checks the signature and freshness of the message and records its id to reject replays.
+func (v *Verifier) Verify(msg Signed, now time.Time) error {
+	if msg.GetId() == "" || msg.GetKeyId() == "" || len(msg.GetSignature()) == 0 {
+		return ErrUnsignedEvent
+	}
+
+	secret, ok := v.keys[msg.GetKeyId()]
+	if !ok {
+		return ErrUnknownKey
+	}
+
+	if !hmac.Equal(signature(secret, msg), msg.GetSignature()) {
+		return ErrInvalidSignature
+	}
+
+	signed := time.UnixMilli(msg.GetTimestamp())
+	if signed.Before(now.Add(-v.window)) || signed.After(now.Add(v.window)) {
+		return ErrStaleEvent
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	// ids older than the window cannot be replayed because their timestamps are already stale
+	if now.Sub(v.pruned) > v.window {
+		for id, at := range v.seen {
+			if now.Sub(at) > 2*v.window {
+				delete(v.seen, id)
+			}
+		}
+
+		v.pruned = now
+	}
+
+	if _, ok := v.seen[msg.GetId()]; ok {
+		return ErrReplayedEvent
+	}
+
+	v.seen[msg.GetId()] = now
+
+	return nil
+}
+
+// signature is the HMAC of every signed field with each field prefixed by its length so that fields cannot be
+// shifted between each other. Registrations and deregistrations are prefixed by their kind so that the signature
+// of one kind of message is never valid for another.
+func signature(secret []byte, msg Signed) []byte {
+	mac := hmac.New(sha256.New, secret)
+
+	switch typed := msg.(type) {
+	case *proto.GameEvent:
+		writeHeader(mac, typed, typed.GetUid())
+		writeField(mac, typed.GetData())
+	case *proto.Registration:
+		writeField(mac, []byte("registration"))
+		writeHeader(mac, typed, typed.GetUid())
+		writeField(mac, binary.BigEndian.AppendUint64(nil, typed.GetCharacter()))
+	case *proto.Deregistration:
+		writeField(mac, []byte("deregistration"))
+		writeHeader(mac, typed, typed.GetUid())
+	default:
+		return nil
+	}
+
+	return mac.Sum(nil)
+}
+
+// writeHeader writes the fields every signed message has in common.
+func writeHeader(mac hash.Hash, msg Signed, uid string) {
+	writeField(mac, []byte(msg.GetKeyId()))
+	writeField(mac, []byte(msg.GetId()))
+	writeField(mac, []byte(uid))
+	writeField(mac, binary.BigEndian.AppendUint64(nil, uint64(msg.GetTimestamp())))
+}
+
+func writeField(mac hash.Hash, field []byte) {
+	mac.Write(binary.BigEndian.AppendUint64(nil, uint64(len(field))))
+	mac.Write(field)
+}
+
+// randomID returns a random hex encoded id.
+func randomID() string {
+	data := make([]byte, 16)
+
+	if _, err := rand.Read(data); err != nil {
+		panic(err)
+	}
+
+	return hex.EncodeToString(data)
+}
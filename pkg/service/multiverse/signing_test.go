@@ -0,0 +1,207 @@
+package multiverse_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ciphermountain/deadenz/pkg/events"
+	proto "github.com/ciphermountain/deadenz/pkg/proto/multiverse"
+	"github.com/ciphermountain/deadenz/pkg/service/multiverse"
+)
+
+func TestVerifier(t *testing.T) {
+	t.Parallel()
+
+	keys := multiverse.Keys{"core": []byte("secret")}
+	now := time.Now()
+
+	signed := func(modify func(*proto.GameEvent)) *proto.GameEvent {
+		event := &proto.GameEvent{Uid: "a", Data: []byte(`{"type":"level_up","value":2}`)}
+
+		multiverse.NewSigner("core", []byte("secret")).Sign(event, now)
+
+		if modify != nil {
+			modify(event)
+		}
+
+		return event
+	}
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name   string
+			event  *proto.GameEvent
+			at     time.Time
+			expect error
+		}{
+			{name: "unsigned", event: &proto.GameEvent{Uid: "a", Data: []byte(`{}`)}, at: now, expect: multiverse.ErrUnsignedEvent},
+			{
+				name:   "unknown key",
+				event:  signed(func(event *proto.GameEvent) { event.KeyId = "other" }),
+				at:     now,
+				expect: multiverse.ErrUnknownKey,
+			},
+			{
+				name:   "tampered player",
+				event:  signed(func(event *proto.GameEvent) { event.Uid = "b" }),
+				at:     now,
+				expect: multiverse.ErrInvalidSignature,
+			},
+			{
+				name:   "tampered data",
+				event:  signed(func(event *proto.GameEvent) { event.Data = []byte(`{}`) }),
+				at:     now,
+				expect: multiverse.ErrInvalidSignature,
+			},
+			{name: "stale", event: signed(nil), at: now.Add(2 * time.Minute), expect: multiverse.ErrStaleEvent},
+		}
+
+		for _, test := range tests {
+			test := test
+
+			t.Run(test.name, func(t *testing.T) {
+				t.Parallel()
+
+				verifier := multiverse.NewVerifier(keys, time.Minute)
+
+				assert.ErrorIs(t, verifier.Verify(test.event, test.at), test.expect)
+			})
+		}
+
+		t.Run("signature of another kind of message", func(t *testing.T) {
+			t.Parallel()
+
+			verifier := multiverse.NewVerifier(keys, time.Minute)
+			reg := &proto.Registration{Uid: "a", Character: 2}
+
+			multiverse.NewSigner("core", []byte("secret")).Sign(reg, now)
+
+			dereg := &proto.Deregistration{
+				Uid:       reg.GetUid(),
+				Id:        reg.GetId(),
+				KeyId:     reg.GetKeyId(),
+				Timestamp: reg.GetTimestamp(),
+				Signature: reg.GetSignature(),
+			}
+
+			assert.ErrorIs(t, verifier.Verify(dereg, now), multiverse.ErrInvalidSignature)
+		})
+
+		t.Run("replayed", func(t *testing.T) {
+			t.Parallel()
+
+			verifier := multiverse.NewVerifier(keys, time.Minute)
+			event := signed(nil)
+
+			require.NoError(t, verifier.Verify(event, now))
+			assert.ErrorIs(t, verifier.Verify(event, now.Add(time.Second)), multiverse.ErrReplayedEvent)
+		})
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := multiverse.NewVerifier(keys, time.Minute)
+
+		assert.NoError(t, verifier.Verify(signed(nil), now))
+		assert.NoError(t, verifier.Verify(signed(nil), now), "events with different ids are not replays")
+	})
+}
+
+func TestMultiverseServer_PublishGameEvent(t *testing.T) {
+	t.Parallel()
+
+	data, err := json.Marshal(events.NewLevelUpEvent(2))
+	require.NoError(t, err)
+
+	server := multiverse.NewMultiverseServer(
+		multiverse.WithVerifier(multiverse.NewVerifier(multiverse.Keys{"core": []byte("secret")}, time.Minute)),
+	)
+
+	defer server.Close()
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := server.PublishGameEvent(context.Background(), &proto.GameEvent{Uid: "a", Data: data})
+
+		require.NoError(t, err)
+		assert.Equal(t, proto.Status_Failure, resp.GetStatus())
+		assert.Equal(t, multiverse.ErrUnsignedEvent.Error(), resp.GetMessage())
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		event := &proto.GameEvent{Uid: "a", Data: data}
+
+		multiverse.NewSigner("core", []byte("secret")).Sign(event, time.Now())
+
+		resp, err := server.PublishGameEvent(context.Background(), event)
+
+		require.NoError(t, err)
+		assert.Equal(t, proto.Status_OK, resp.GetStatus())
+	})
+}
+
+func TestMultiverseServer_Register(t *testing.T) {
+	t.Parallel()
+
+	signer := multiverse.NewSigner("core", []byte("secret"))
+	server := multiverse.NewMultiverseServer(
+		multiverse.WithVerifier(multiverse.NewVerifier(multiverse.Keys{"core": []byte("secret")}, time.Minute)),
+	)
+
+	defer server.Close()
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := server.Register(context.Background(), &proto.Registration{Uid: "a", Character: 2})
+
+		require.NoError(t, err)
+		assert.Equal(t, proto.Status_Failure, resp.GetStatus())
+		assert.Equal(t, multiverse.ErrUnsignedEvent.Error(), resp.GetMessage())
+
+		tampered := &proto.Registration{Uid: "a", Character: 2}
+		signer.Sign(tampered, time.Now())
+		tampered.Character = 3
+
+		resp, err = server.Register(context.Background(), tampered)
+
+		require.NoError(t, err)
+		assert.Equal(t, multiverse.ErrInvalidSignature.Error(), resp.GetMessage())
+
+		resp, err = server.Deregister(context.Background(), &proto.Deregistration{Uid: "a"})
+
+		require.NoError(t, err)
+		assert.Equal(t, multiverse.ErrUnsignedEvent.Error(), resp.GetMessage())
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		reg := &proto.Registration{Uid: "b", Character: 2}
+		signer.Sign(reg, time.Now())
+
+		resp, err := server.Register(context.Background(), reg)
+
+		require.NoError(t, err)
+		assert.Equal(t, proto.Status_OK, resp.GetStatus())
+
+		dereg := &proto.Deregistration{Uid: "b"}
+		signer.Sign(dereg, time.Now())
+
+		resp, err = server.Deregister(context.Background(), dereg)
+
+		require.NoError(t, err)
+		assert.Equal(t, proto.Status_OK, resp.GetStatus())
+	})
+}
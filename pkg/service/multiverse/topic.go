@@ -0,0 +1,106 @@
+package multiverse
+
+import (
+	"github.com/ciphermountain/deadenz/pkg/components"
+	proto "github.com/ciphermountain/deadenz/pkg/proto/multiverse"
+)
+
+// topic describes what an event is about so that it can be matched against subscriber filters.
+type topic struct {
+	kind proto.EventType
+	// player is the player that caused the event. It is empty for events not caused by a player.
+	player string
+	// character is nil when the event is not about a character type.
+	character *components.CharacterType
+}
+
+// subscription is the decoded form of a subscriber filter. A nil set matches every value.
+type subscription struct {
+	types      map[proto.EventType]struct{}
+	players    map[string]struct{}
+	characters map[components.CharacterType]struct{}
+}
+
+func newSubscription(filter *proto.Filter) subscription {
+	var sub subscription
+
+	if len(filter.GetTypes()) > 0 {
+		sub.types = make(map[proto.EventType]struct{}, len(filter.GetTypes()))
+
+		for _, kind := range filter.GetTypes() {
+			sub.types[kind] = struct{}{}
+		}
+	}
+
+	if len(filter.GetRecipients()) > 0 {
+		sub.players = make(map[string]struct{}, len(filter.GetRecipients()))
+
+		for _, uid := range filter.GetRecipients() {
+			sub.players[uid] = struct{}{}
+		}
+	}
+
+	if len(filter.GetCharacters()) > 0 {
+		sub.characters = make(map[components.CharacterType]struct{}, len(filter.GetCharacters()))
+
+		for _, character := range filter.GetCharacters() {
+			sub.characters[components.CharacterType(character)] = struct{}{}
+		}
+	}
+
+	return sub
+}
+
+// matches indicates whether the subscription accepts events on the topic. Announcements are not caused by a
+// player or about a character and are only filtered by type.
+func (s subscription) matches(t topic) bool {
+	if s.types != nil {
+		if _, ok := s.types[t.kind]; !ok {
+			return false
+		}
+	}
+
+	if t.kind == proto.EventType_AnnouncementEvent {
+		return true
+	}
+
+	if s.players != nil {
+		if _, ok := s.players[t.player]; !ok {
+			return false
+		}
+	}
+
+	if s.characters != nil {
+		if t.character == nil {
+			return false
+		}
+
+		if _, ok := s.characters[*t.character]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// eventType returns the subscription type of the event.
+func eventType(event *proto.Event) proto.EventType {
+	switch event.GetType().(type) {
+	case *proto.Event_CharacterDeath:
+		return proto.EventType_CharacterDeathEvent
+	case *proto.Event_ItemCrafted:
+		return proto.EventType_ItemCraftedEvent
+	case *proto.Event_RareItemFound:
+		return proto.EventType_RareItemFoundEvent
+	case *proto.Event_LevelUp:
+		return proto.EventType_LevelUpEvent
+	case *proto.Event_FirstSpawn:
+		return proto.EventType_FirstSpawnEvent
+	case *proto.Event_XpMilestone:
+		return proto.EventType_XPMilestoneEvent
+	case *proto.Event_Announcement:
+		return proto.EventType_AnnouncementEvent
+	default:
+		return proto.EventType_UnknownEvent
+	}
+}
@@ -0,0 +1,72 @@
+package multiverse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ciphermountain/deadenz/pkg/components"
+	proto "github.com/ciphermountain/deadenz/pkg/proto/multiverse"
+)
+
+func TestSubscriptionMatches(t *testing.T) {
+	t.Parallel()
+
+	character := components.CharacterType(3)
+	other := components.CharacterType(4)
+
+	levelUp := topic{kind: proto.EventType_LevelUpEvent, player: "a", character: &character}
+	milestone := topic{kind: proto.EventType_XPMilestoneEvent, player: "b"}
+	announcement := topic{kind: proto.EventType_AnnouncementEvent}
+
+	tests := []struct {
+		name    string
+		filter  *proto.Filter
+		topic   topic
+		matches bool
+	}{
+		{name: "empty filter matches everything", filter: &proto.Filter{}, topic: levelUp, matches: true},
+		{
+			name:    "matching type",
+			filter:  &proto.Filter{Types: []proto.EventType{proto.EventType_LevelUpEvent}},
+			topic:   levelUp,
+			matches: true,
+		},
+		{
+			name:   "other type",
+			filter: &proto.Filter{Types: []proto.EventType{proto.EventType_LevelUpEvent}},
+			topic:  milestone,
+		},
+		{name: "matching player", filter: &proto.Filter{Recipients: []string{"a", "c"}}, topic: levelUp, matches: true},
+		{name: "other player", filter: &proto.Filter{Recipients: []string{"c"}}, topic: levelUp},
+		{
+			name:    "matching character",
+			filter:  &proto.Filter{Characters: []uint64{uint64(character)}},
+			topic:   levelUp,
+			matches: true,
+		},
+		{name: "other character", filter: &proto.Filter{Characters: []uint64{uint64(other)}}, topic: levelUp},
+		{name: "event without a character", filter: &proto.Filter{Characters: []uint64{uint64(character)}}, topic: milestone},
+		{
+			name:    "announcements ignore players and characters",
+			filter:  &proto.Filter{Recipients: []string{"c"}, Characters: []uint64{uint64(other)}},
+			topic:   announcement,
+			matches: true,
+		},
+		{
+			name:   "announcements are filtered by type",
+			filter: &proto.Filter{Types: []proto.EventType{proto.EventType_LevelUpEvent}},
+			topic:  announcement,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.matches, newSubscription(test.filter).matches(test.topic))
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package transport
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var (
+	ErrMissingKeyPair = errors.New("tls certificate and key must be provided together")
+	ErrMissingCA      = errors.New("tls ca is required to verify client certificates")
+	ErrInvalidCA      = errors.New("tls ca contains no certificates")
+)
+
+// TLSConfig locates the certificates used to secure connections. An empty config uses insecure connections.
+type TLSConfig struct {
+	// Cert and Key are the PEM encoded certificate and private key presented to the other side of the connection.
+	Cert string
+	Key  string
+	// CA is a PEM bundle of the authorities trusted to sign the certificate of the other side. The system roots are
+	// trusted by clients when empty.
+	CA string
+	// VerifyClients requires servers to verify a client certificate signed by the CA.
+	VerifyClients bool
+	// Enabled makes clients use TLS without a certificate or CA of their own.
+	Enabled bool
+	// ServerName overrides the name clients verify the server certificate against.
+	ServerName string
+}
+
+// ServerCredentials returns the credentials a server listens with. Servers without a certificate are insecure.
+func ServerCredentials(conf TLSConfig) (credentials.TransportCredentials, error) {
+	if conf.Cert == "" && conf.Key == "" {
+		if conf.VerifyClients {
+			return nil, ErrMissingKeyPair
+		}
+
+		return insecure.NewCredentials(), nil
+	}
+
+	cert, err := loadKeyPair(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConf := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if conf.VerifyClients {
+		if conf.CA == "" {
+			return nil, ErrMissingCA
+		}
+
+		if tlsConf.ClientCAs, err = loadCA(conf.CA); err != nil {
+			return nil, err
+		}
+
+		tlsConf.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return credentials.NewTLS(tlsConf), nil
+}
+
+// ClientCredentials returns the credentials a client dials with. A client certificate is presented when one is
+// configured so that servers verifying clients accept the connection.
+func ClientCredentials(conf TLSConfig) (credentials.TransportCredentials, error) {
+	if !conf.Enabled && conf.CA == "" && conf.Cert == "" && conf.Key == "" {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsConf := &tls.Config{
+		ServerName: conf.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if conf.Cert != "" || conf.Key != "" {
+		cert, err := loadKeyPair(conf)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConf.Certificates = []tls.Certificate{cert}
+	}
+
+	if conf.CA != "" {
+		pool, err := loadCA(conf.CA)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConf.RootCAs = pool
+	}
+
+	return credentials.NewTLS(tlsConf), nil
+}
+
+func loadKeyPair(conf TLSConfig) (tls.Certificate, error) {
+	if conf.Cert == "" || conf.Key == "" {
+		return tls.Certificate{}, ErrMissingKeyPair
+	}
+
+	return tls.LoadX509KeyPair(conf.Cert, conf.Key)
+}
+
+func loadCA(path string) (*x509.CertPool, error) {
+	bts, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bts) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCA, path)
+	}
+
+	return pool, nil
+}
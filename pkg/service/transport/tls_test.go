@@ -0,0 +1,269 @@
+package transport_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/ciphermountain/deadenz/pkg/service/transport"
+)
+
+func TestCredentials(t *testing.T) {
+	t.Parallel()
+
+	pki := newTestPKI(t)
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := transport.ServerCredentials(transport.TLSConfig{Cert: pki.serverCert})
+		assert.ErrorIs(t, err, transport.ErrMissingKeyPair)
+
+		_, err = transport.ServerCredentials(transport.TLSConfig{Cert: pki.serverCert, Key: pki.serverKey, VerifyClients: true})
+		assert.ErrorIs(t, err, transport.ErrMissingCA)
+
+		_, err = transport.ClientCredentials(transport.TLSConfig{CA: pki.serverKey})
+		assert.ErrorIs(t, err, transport.ErrInvalidCA)
+
+		// a server verifying clients rejects clients without a certificate
+		serverCreds, err := transport.ServerCredentials(transport.TLSConfig{
+			Cert:          pki.serverCert,
+			Key:           pki.serverKey,
+			CA:            pki.ca,
+			VerifyClients: true,
+		})
+		require.NoError(t, err)
+
+		clientCreds, err := transport.ClientCredentials(transport.TLSConfig{CA: pki.ca})
+		require.NoError(t, err)
+
+		assert.Error(t, handshake(t, serverCreds, clientCreds))
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		serverCreds, err := transport.ServerCredentials(transport.TLSConfig{Cert: pki.serverCert, Key: pki.serverKey})
+		require.NoError(t, err)
+
+		clientCreds, err := transport.ClientCredentials(transport.TLSConfig{CA: pki.ca})
+		require.NoError(t, err)
+
+		assert.NoError(t, handshake(t, serverCreds, clientCreds))
+
+		mutualServerCreds, err := transport.ServerCredentials(transport.TLSConfig{
+			Cert:          pki.serverCert,
+			Key:           pki.serverKey,
+			CA:            pki.ca,
+			VerifyClients: true,
+		})
+		require.NoError(t, err)
+
+		mutualClientCreds, err := transport.ClientCredentials(transport.TLSConfig{
+			Cert: pki.clientCert,
+			Key:  pki.clientKey,
+			CA:   pki.ca,
+		})
+		require.NoError(t, err)
+
+		assert.NoError(t, handshake(t, mutualServerCreds, mutualClientCreds))
+	})
+}
+
+func TestCredentials_DistinctAuthorities(t *testing.T) {
+	t.Parallel()
+
+	// the multiverse and core servers are issued certificates by different authorities
+	dir := t.TempDir()
+	multiverseCA := newTestCA(t, dir, "multiverse-ca")
+	coreCA := newTestCA(t, dir, "core-ca")
+
+	multiverseCert, multiverseKey := multiverseCA.issue(t, 2, "multiverse", x509.ExtKeyUsageServerAuth)
+	coreCert, coreKey := coreCA.issue(t, 2, "core", x509.ExtKeyUsageClientAuth)
+
+	// the multiverse verifies core servers against the core authority
+	multiverseCreds, err := transport.ServerCredentials(transport.TLSConfig{
+		Cert:          multiverseCert,
+		Key:           multiverseKey,
+		CA:            coreCA.path,
+		VerifyClients: true,
+	})
+	require.NoError(t, err)
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		// dialing with the authority core verifies its own clients against does not trust the multiverse
+		coreCreds, err := transport.ClientCredentials(transport.TLSConfig{
+			Cert: coreCert,
+			Key:  coreKey,
+			CA:   coreCA.path,
+		})
+		require.NoError(t, err)
+
+		assert.Error(t, handshake(t, multiverseCreds, coreCreds))
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		coreCreds, err := transport.ClientCredentials(transport.TLSConfig{
+			Cert: coreCert,
+			Key:  coreKey,
+			CA:   multiverseCA.path,
+		})
+		require.NoError(t, err)
+
+		assert.NoError(t, handshake(t, multiverseCreds, coreCreds))
+	})
+}
+
+// handshake performs a tls handshake between the credentials over a loopback connection and returns the error of
+// either side.
+func handshake(t *testing.T, server, client credentials.TransportCredentials) error {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	defer listener.Close()
+
+	serverErr := make(chan error, 1)
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			serverErr <- err
+
+			return
+		}
+
+		defer conn.Close()
+
+		_, _, err = server.ServerHandshake(conn)
+
+		serverErr <- err
+	}()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, _, err := client.ClientHandshake(ctx, "localhost", conn); err != nil {
+		return err
+	}
+
+	return <-serverErr
+}
+
+type testPKI struct {
+	ca         string
+	serverCert string
+	serverKey  string
+	clientCert string
+	clientKey  string
+}
+
+func newTestPKI(t *testing.T) testPKI {
+	t.Helper()
+
+	ca := newTestCA(t, t.TempDir(), "ca")
+	pki := testPKI{ca: ca.path}
+
+	pki.serverCert, pki.serverKey = ca.issue(t, 2, "server", x509.ExtKeyUsageServerAuth)
+	pki.clientCert, pki.clientKey = ca.issue(t, 3, "client", x509.ExtKeyUsageClientAuth)
+
+	return pki
+}
+
+// testCA is a certificate authority that issues certificates into a directory.
+type testCA struct {
+	dir  string
+	path string
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T, dir, name string) testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return testCA{
+		dir:  dir,
+		path: writePEM(t, dir, name+".pem", "CERTIFICATE", der),
+		cert: cert,
+		key:  key,
+	}
+}
+
+// issue returns the paths of a certificate and key for the name that is valid for localhost.
+func (ca testCA) issue(t *testing.T, serial int64, name string, usage x509.ExtKeyUsage) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return writePEM(t, ca.dir, name+".pem", "CERTIFICATE", der), writePEM(t, ca.dir, name+"-key.pem", "EC PRIVATE KEY", keyDER)
+}
+
+func writePEM(t *testing.T, dir, name, kind string, der []byte) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: kind, Bytes: der}), 0o600))
+
+	return path
+}
@@ -24,21 +24,26 @@ type Loader interface {
 // and stats. Events emitted include spawn event and earned xp event. Will return
 // an already spawned error if profile has an active character.
 func Spawn(profile *components.Profile, loader Loader) (*components.Profile, []components.Event, error) {
+	return SpawnCtx(context.Background(), profile, loader)
+}
+
+// SpawnCtx is Spawn with a context that is passed to the loader.
+func SpawnCtx(ctx context.Context, profile *components.Profile, loader Loader) (*components.Profile, []components.Event, error) {
 	// short circuit if the user has an active character
 	if profile.Active != nil {
 		return profile, nil, ErrAlreadySpawnedIn
 	}
 
 	var characters []components.Character
-	if err := loader.Load(&characters); err != nil {
+	if err := loader.LoadCtx(ctx, &characters); err != nil {
 		return profile, nil, err
 	}
 
 	char := characters[util.Random(0, int64(len(characters)-1))]
 
 	profile.XP = profile.XP + uint(char.Multiplier)
+	// the multiverse registration middleware registers the character from the spawn event
 	profile.Active = &char
-	// TODO: register in multiverse
 
 	evts := []components.Event{
 		events.NewCharacterSpawnEvent(char),
@@ -47,3 +52,7 @@ func Spawn(profile *components.Profile, loader Loader) (*components.Profile, []c
 
 	return profile, evts, nil
 }
+
+func spawnCommand(ctx context.Context, profile *components.Profile, loader Loader, _ CommandConfig) (*components.Profile, []components.Event, error) {
+	return SpawnCtx(ctx, profile, loader)
+}
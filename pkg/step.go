@@ -1,19 +1,62 @@
 package deadenz
 
 import (
+	"context"
 	"errors"
+	"fmt"
 
 	"github.com/ciphermountain/deadenz/pkg/components"
 	"github.com/ciphermountain/deadenz/pkg/events"
 )
 
-var ErrUnrecognizedCommand = errors.New("unrecognized command")
+// MaxRepeat is the most times a repeatable command runs in a single request.
+const MaxRepeat uint = 100
+
+var (
+	ErrUnrecognizedCommand = errors.New("unrecognized command")
+	ErrNotRepeatable       = errors.New("command cannot be repeated")
+	ErrCharacterDied       = errors.New("your character died")
+)
+
+// StopRepeat marks the error of a command that completed but cannot continue to repeat. The step is kept and the
+// error is reported as the reason a repeated command stopped. The error is dropped when the command is not repeated.
+func StopRepeat(err error) error {
+	return repeatStop{err: err}
+}
+
+type repeatStop struct {
+	err error
+}
+
+func (e repeatStop) Error() string {
+	return e.err.Error()
+}
+
+func (e repeatStop) Unwrap() error {
+	return e.err
+}
+
+type repeatStepKey struct{}
+
+// RepeatStep returns the number of runs a repeated command has completed in the current request before the run
+// the context belongs to. It is zero for commands that are not repeated.
+func RepeatStep(ctx context.Context) uint {
+	step, _ := ctx.Value(repeatStepKey{}).(uint)
+
+	return step
+}
 
 // Result represents the state change of applying one step of the game on a player profile.
 type Result struct {
 	DefaultCmd CommandType
 	Profile    *components.Profile
 	Events     []components.Event
+	// Steps is the number of times the command ran.
+	Steps uint
+	// Stopped is the reason a repeated command ended before running the requested number of times.
+	Stopped error
+	// Diff lists the changes made to the profile by the command.
+	Diff components.ProfileDiff
 }
 
 // PreRunFunc can read a profile, modify and return it.
@@ -22,73 +65,192 @@ type PreRunFunc func(CommandType, *components.Profile) (*components.Profile, err
 // PreRunFunc can read a profile with events, modify the profile, and return it.
 type PostRunFunc func(CommandType, *components.Profile, []components.Event) (*components.Profile, error)
 
+// PreRunCtxFunc is a PreRunFunc that receives the context of the command.
+type PreRunCtxFunc func(context.Context, CommandType, *components.Profile) (*components.Profile, error)
+
+// PostRunCtxFunc is a PostRunFunc that receives the context of the command.
+type PostRunCtxFunc func(context.Context, CommandType, *components.Profile, []components.Event) (*components.Profile, error)
+
+// PreRunWithContext adapts a PreRunFunc to a PreRunCtxFunc that ignores the context.
+func PreRunWithContext(f PreRunFunc) PreRunCtxFunc {
+	return func(_ context.Context, cmd CommandType, profile *components.Profile) (*components.Profile, error) {
+		return f(cmd, profile)
+	}
+}
+
+// PostRunWithContext adapts a PostRunFunc to a PostRunCtxFunc that ignores the context.
+func PostRunWithContext(f PostRunFunc) PostRunCtxFunc {
+	return func(_ context.Context, cmd CommandType, profile *components.Profile, evts []components.Event) (*components.Profile, error) {
+		return f(cmd, profile, evts)
+	}
+}
+
 func RunActionCommand(
 	command CommandType,
 	profile *components.Profile,
 	loader Loader,
 	preRun []PreRunFunc,
 	postRun []PostRunFunc,
+	opts ...CommandOpt,
+) (Result, error) {
+	preRunCtx := make([]PreRunCtxFunc, len(preRun))
+	for idx, f := range preRun {
+		preRunCtx[idx] = PreRunWithContext(f)
+	}
+
+	postRunCtx := make([]PostRunCtxFunc, len(postRun))
+	for idx, f := range postRun {
+		postRunCtx[idx] = PostRunWithContext(f)
+	}
+
+	return RunActionCommandCtx(context.Background(), command, profile, loader, preRunCtx, postRunCtx, opts...)
+}
+
+// RunActionCommandCtx is RunActionCommand with a context that is passed to the middleware and the loader.
+func RunActionCommandCtx(
+	ctx context.Context,
+	command CommandType,
+	profile *components.Profile,
+	loader Loader,
+	preRun []PreRunCtxFunc,
+	postRun []PostRunCtxFunc,
+	opts ...CommandOpt,
+) (Result, error) {
+	return RunWithMiddleware(ctx, command, profile, loader, MiddlewareFromRunFuncs(preRun, postRun), opts...)
+}
+
+// RunWithMiddleware runs an action command through the middleware chain where the first middleware is the
+// outermost. The command runs on a copy of the profile so the provided profile is never modified and an
+// unmodified copy is returned with any error.
+func RunWithMiddleware(
+	ctx context.Context,
+	command CommandType,
+	profile *components.Profile,
+	loader Loader,
+	middleware []Middleware,
+	opts ...CommandOpt,
 ) (Result, error) {
 	if profile == nil {
 		return Result{}, errors.New("profile required")
 	}
 
-	original := *profile
-	step := Result{
-		Profile: profile,
+	var conf CommandConfig
+	for _, opt := range opts {
+		opt(&conf)
 	}
 
-	for idx := range preRun {
-		var err error
+	handler := Chain(middleware...)(commandHandler(loader, conf))
 
-		step.Profile, err = preRun[idx](command, step.Profile)
-		if err != nil {
-			return Result{Profile: &original}, err
+	if conf.Repeat > 1 {
+		cmd, ok := LookupCommand(command)
+		if !ok || !cmd.Repeatable {
+			return Result{Profile: profile.Clone()}, fmt.Errorf("%w: %s", ErrNotRepeatable, command)
 		}
+
+		handler = repeatHandler(handler, conf.Repeat)
 	}
-	// return profile, errors.New("you have walked too much. you need to rest for an hour. there's a park bench nearby")
 
-	switch command {
-	case SpawninCommandType:
-		var err error
+	result, err := handler(ctx, command, profile.Clone())
+	if err != nil {
+		return Result{Profile: profile.Clone()}, err
+	}
 
-		step.Profile, step.Events, err = Spawn(step.Profile, loader)
-		if err != nil {
-			return Result{Profile: &original}, err
-		}
+	result.Diff = components.DiffProfiles(profile, result.Profile)
 
-		step.DefaultCmd = WalkCommandType
-	case WalkCommandType:
-		var err error
+	return result, nil
+}
 
-		step.Profile, step.Events, err = Walk(step.Profile, loader)
-		if err != nil {
-			if !errors.Is(err, ErrBackpackTooSmall) {
-				return Result{Profile: &original}, err
+// repeatHandler runs the handler up to the provided number of times and aggregates the events of every run. It
+// stops early when the character dies, a run is stopped by the command, or a run fails. A failure is only returned when the
+// first run fails and is otherwise reported as the reason the repeat stopped.
+func repeatHandler(next Handler, times uint) Handler {
+	return func(ctx context.Context, command CommandType, profile *components.Profile) (Result, error) {
+		var aggregate Result
+
+		for aggregate.Steps < times {
+			previous := profile.Clone()
+
+			step, err := next(context.WithValue(ctx, repeatStepKey{}, aggregate.Steps), command, profile)
+			if err != nil {
+				if aggregate.Steps == 0 {
+					return step, err
+				}
+
+				aggregate.Profile = previous
+				aggregate.Stopped = err
+
+				break
 			}
 
-			// TODO: make a better message
-			step.Events = append(step.Events, events.NewItemDecisionEvent("your backpack is too small"))
-			err = nil
-		}
+			aggregate.Steps++
+			aggregate.DefaultCmd = step.DefaultCmd
+			aggregate.Profile = step.Profile
+			aggregate.Events = append(aggregate.Events, step.Events...)
+			profile = step.Profile
 
-		step.DefaultCmd = WalkCommandType
+			stop := step.Stopped
+			if stop == nil && profile.Active == nil {
+				stop = ErrCharacterDied
+			}
+
+			if stop != nil {
+				if aggregate.Steps < times {
+					aggregate.Stopped = stop
+				}
 
-		if profile.Active == nil {
-			step.DefaultCmd = SpawninCommandType
+				break
+			}
 		}
-	default:
-		return step, ErrUnrecognizedCommand
+
+		return aggregate, nil
 	}
+}
+
+// commandHandler is the innermost handler that applies a single registered game command to a profile.
+func commandHandler(loader Loader, conf CommandConfig) Handler {
+	return func(ctx context.Context, command CommandType, profile *components.Profile) (Result, error) {
+		step := Result{
+			Profile: profile,
+		}
+
+		cmd, ok := LookupCommand(command)
+		if !ok || cmd.Handler == nil {
+			return step, ErrUnrecognizedCommand
+		}
+
+		if err := ctx.Err(); err != nil {
+			return step, err
+		}
 
-	for idx := range postRun {
 		var err error
 
-		step.Profile, err = postRun[idx](command, step.Profile, step.Events)
+		xp := profile.XP
+
+		step.Profile, step.Events, err = cmd.Handler(ctx, step.Profile, loader, conf)
+
+		var stop repeatStop
+		if errors.As(err, &stop) {
+			if conf.Repeat > 1 {
+				step.Stopped = stop.err
+			}
+
+			err = nil
+		}
+
 		if err != nil {
-			return Result{Profile: &original}, err
+			return step, err
 		}
-	}
 
-	return step, nil
+		step.Events = append(step.Events, events.ProgressEvents(xp, step.Profile.XP)...)
+
+		next := cmd.Next
+		if next == nil {
+			next = DefaultNextCommand
+		}
+
+		step.DefaultCmd = next(step.Profile)
+		step.Steps = 1
+
+		return step, nil
+	}
 }
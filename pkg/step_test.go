@@ -0,0 +1,354 @@
+package deadenz_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	deadenz "github.com/ciphermountain/deadenz/pkg"
+	"github.com/ciphermountain/deadenz/pkg/components"
+	"github.com/ciphermountain/deadenz/pkg/events"
+)
+
+func TestRunWithMiddleware_Repeat(t *testing.T) {
+	t.Parallel()
+
+	// forage earns a token each step and the character dies once it holds three
+	forage := deadenz.RegisterCommand(deadenz.Command{
+		Name: "test_forage",
+		Handler: func(_ context.Context, profile *components.Profile, _ deadenz.Loader, _ deadenz.CommandConfig) (*components.Profile, []components.Event, error) {
+			profile.Currency++
+
+			if profile.Currency >= 3 {
+				profile.Active = nil
+			}
+
+			return profile, []components.Event{events.NewEarnedTokenEvent(1)}, nil
+		},
+		Repeatable: true,
+	})
+
+	t.Cleanup(func() { deadenz.UnregisterCommand(forage) })
+
+	// gather adds wood to the backpack and keeps the step when the wood does not fit
+	wood := components.Item{Type: 1, Name: "wood", StackSize: 3, Weight: 1}
+	gather := deadenz.RegisterCommand(deadenz.Command{
+		Name: "test_gather",
+		Handler: func(_ context.Context, profile *components.Profile, _ deadenz.Loader, _ deadenz.CommandConfig) (*components.Profile, []components.Event, error) {
+			backpack, err := profile.Backpack.Add(wood, 1, profile.BackpackLimit, profile.CarryCapacity)
+			if err != nil {
+				return profile, nil, deadenz.StopRepeat(err)
+			}
+
+			profile.Backpack = backpack
+
+			return profile, []components.Event{events.NewFindEvent(wood)}, nil
+		},
+		Repeatable: true,
+	})
+
+	t.Cleanup(func() { deadenz.UnregisterCommand(gather) })
+
+	errLimited := errors.New("limited")
+	limiter := func(limit uint) deadenz.PreRunCtxFunc {
+		return func(_ context.Context, _ deadenz.CommandType, profile *components.Profile) (*components.Profile, error) {
+			if profile.Currency >= limit {
+				return profile, errLimited
+			}
+
+			return profile, nil
+		}
+	}
+
+	newProfile := func() *components.Profile {
+		return &components.Profile{Active: &components.Character{Name: "test"}}
+	}
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("non-repeatable commands should not repeat", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := deadenz.RunWithMiddleware(context.Background(), deadenz.CraftCommandType, newProfile(), nil, nil, deadenz.WithRepeat(2))
+
+			require.ErrorIs(t, err, deadenz.ErrNotRepeatable)
+		})
+
+		t.Run("failing first step should return error", func(t *testing.T) {
+			t.Parallel()
+
+			mw := deadenz.MiddlewareFromRunFuncs([]deadenz.PreRunCtxFunc{limiter(0)}, nil)
+			_, err := deadenz.RunWithMiddleware(context.Background(), forage, newProfile(), nil, mw, deadenz.WithRepeat(2))
+
+			require.ErrorIs(t, err, errLimited)
+		})
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("repeat runs the requested number of steps", func(t *testing.T) {
+			t.Parallel()
+
+			result, err := deadenz.RunWithMiddleware(context.Background(), forage, newProfile(), nil, nil, deadenz.WithRepeat(2))
+
+			require.NoError(t, err)
+			assert.Equal(t, uint(2), result.Steps)
+			assert.Equal(t, uint(2), result.Profile.Currency)
+			assert.Len(t, result.Events, 2)
+			assert.NoError(t, result.Stopped)
+		})
+
+		t.Run("repeat stops when the character dies", func(t *testing.T) {
+			t.Parallel()
+
+			result, err := deadenz.RunWithMiddleware(context.Background(), forage, newProfile(), nil, nil, deadenz.WithRepeatUntilStopped())
+
+			require.NoError(t, err)
+			assert.Equal(t, uint(3), result.Steps)
+			assert.ErrorIs(t, result.Stopped, deadenz.ErrCharacterDied)
+			assert.Equal(t, deadenz.SpawninCommandType, result.DefaultCmd)
+		})
+
+		t.Run("repeat continues while items stack in a full-slot backpack", func(t *testing.T) {
+			t.Parallel()
+
+			// every slot is used but the stack can hold two more wood
+			profile := newProfile()
+			profile.BackpackLimit = 1
+			profile.Backpack = components.Backpack{{Type: wood.Type, Quantity: 1, Weight: wood.Weight}}
+
+			result, err := deadenz.RunWithMiddleware(context.Background(), gather, profile, nil, nil, deadenz.WithRepeat(5))
+
+			require.NoError(t, err)
+			assert.Equal(t, uint(3), result.Steps)
+			assert.Equal(t, uint32(3), result.Profile.Backpack.Quantity(wood.Type))
+			assert.ErrorIs(t, result.Stopped, components.ErrNotEnoughSlots)
+		})
+
+		t.Run("stopping a single run is not reported", func(t *testing.T) {
+			t.Parallel()
+
+			profile := newProfile()
+			profile.BackpackLimit = 1
+			profile.Backpack = components.Backpack{{Type: wood.Type, Quantity: wood.StackSize, Weight: wood.Weight}}
+
+			result, err := deadenz.RunWithMiddleware(context.Background(), gather, profile, nil, nil)
+
+			require.NoError(t, err)
+			assert.Equal(t, uint(1), result.Steps)
+			assert.NoError(t, result.Stopped)
+		})
+
+		t.Run("repeat stops when middleware fails and keeps completed steps", func(t *testing.T) {
+			t.Parallel()
+
+			mw := deadenz.MiddlewareFromRunFuncs([]deadenz.PreRunCtxFunc{limiter(2)}, nil)
+			result, err := deadenz.RunWithMiddleware(context.Background(), forage, newProfile(), nil, mw, deadenz.WithRepeat(5))
+
+			require.NoError(t, err)
+			assert.Equal(t, uint(2), result.Steps)
+			assert.Equal(t, uint(2), result.Profile.Currency)
+			assert.ErrorIs(t, result.Stopped, errLimited)
+		})
+	})
+}
+
+func TestRunWithMiddleware_Rollback(t *testing.T) {
+	t.Parallel()
+
+	noop := deadenz.RegisterCommand(deadenz.Command{
+		Name: "test_noop",
+		Handler: func(_ context.Context, profile *components.Profile, _ deadenz.Loader, _ deadenz.CommandConfig) (*components.Profile, []components.Event, error) {
+			return profile, nil, nil
+		},
+	})
+
+	t.Cleanup(func() { deadenz.UnregisterCommand(noop) })
+
+	errFailed := errors.New("failed")
+	mutateAndFail := func(_ context.Context, _ deadenz.CommandType, profile *components.Profile, _ []components.Event) (*components.Profile, error) {
+		profile.Backpack[0].Quantity = 10
+		profile.Limits.WalkCount = 10
+		profile.Active.Name = "a ghost"
+
+		return profile, errFailed
+	}
+
+	profile := &components.Profile{
+		Active:   &components.Character{Name: "a zombie"},
+		Backpack: components.Backpack{{Type: 1, Quantity: 1}},
+		Limits:   &components.Limits{WalkCount: 1},
+	}
+	original := profile.Clone()
+
+	mw := deadenz.MiddlewareFromRunFuncs(nil, []deadenz.PostRunCtxFunc{mutateAndFail})
+	result, err := deadenz.RunWithMiddleware(context.Background(), noop, profile, nil, mw)
+
+	require.ErrorIs(t, err, errFailed)
+	assert.Equal(t, original, profile, "provided profile should not be modified")
+	assert.Equal(t, original, result.Profile)
+}
+
+func TestRunWithMiddleware_Progress(t *testing.T) {
+	t.Parallel()
+
+	train := deadenz.RegisterCommand(deadenz.Command{
+		Name: "test_train",
+		Handler: func(_ context.Context, profile *components.Profile, _ deadenz.Loader, _ deadenz.CommandConfig) (*components.Profile, []components.Event, error) {
+			profile.XP += 100
+
+			return profile, nil, nil
+		},
+	})
+
+	t.Cleanup(func() { deadenz.UnregisterCommand(train) })
+
+	result, err := deadenz.RunWithMiddleware(context.Background(), train, &components.Profile{XP: 950}, nil, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, []components.Event{
+		events.NewLevelUpEvent(components.Level(1050)),
+		events.NewXPMilestoneEvent(1000),
+	}, result.Events)
+}
+
+func TestRunActionCommandCtx(t *testing.T) {
+	t.Parallel()
+
+	type requestKey struct{}
+
+	// search loads items with the context of the command and counts its steps
+	search := deadenz.RegisterCommand(deadenz.Command{
+		Name: "test_search",
+		Handler: func(ctx context.Context, profile *components.Profile, loader deadenz.Loader, _ deadenz.CommandConfig) (*components.Profile, []components.Event, error) {
+			var items []components.Item
+			if err := loader.LoadCtx(ctx, &items); err != nil {
+				return profile, nil, err
+			}
+
+			profile.XP++
+
+			return profile, nil, nil
+		},
+		Repeatable: true,
+	})
+
+	t.Cleanup(func() { deadenz.UnregisterCommand(search) })
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("cancelled context should not run the chain", func(t *testing.T) {
+			t.Parallel()
+
+			var ran bool
+
+			preRun := func(_ context.Context, _ deadenz.CommandType, profile *components.Profile) (*components.Profile, error) {
+				ran = true
+
+				return profile, nil
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			profile := &components.Profile{Active: &components.Character{}}
+			result, err := deadenz.RunActionCommandCtx(ctx, search, profile, &contextLoader{}, []deadenz.PreRunCtxFunc{preRun}, nil)
+
+			require.ErrorIs(t, err, context.Canceled)
+			assert.False(t, ran, "pre-run middleware should not run")
+			assert.Equal(t, profile, result.Profile)
+		})
+
+		t.Run("cancelling a repeated command should stop after the current step", func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			cancelAfter := func(_ context.Context, _ deadenz.CommandType, profile *components.Profile, _ []components.Event) (*components.Profile, error) {
+				if profile.XP == 2 {
+					cancel()
+				}
+
+				return profile, nil
+			}
+
+			result, err := deadenz.RunActionCommandCtx(
+				ctx,
+				search,
+				&components.Profile{Active: &components.Character{}},
+				&contextLoader{},
+				nil,
+				[]deadenz.PostRunCtxFunc{cancelAfter},
+				deadenz.WithRepeat(5),
+			)
+
+			require.NoError(t, err)
+			require.ErrorIs(t, result.Stopped, context.Canceled)
+
+			assert.Equal(t, uint(2), result.Steps)
+			assert.Equal(t, uint(2), result.Profile.XP)
+		})
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("context reaches middleware and the loader", func(t *testing.T) {
+			t.Parallel()
+
+			var preRunValue, postRunValue any
+
+			preRun := func(ctx context.Context, _ deadenz.CommandType, profile *components.Profile) (*components.Profile, error) {
+				preRunValue = ctx.Value(requestKey{})
+
+				return profile, nil
+			}
+
+			postRun := func(ctx context.Context, _ deadenz.CommandType, profile *components.Profile, _ []components.Event) (*components.Profile, error) {
+				postRunValue = ctx.Value(requestKey{})
+
+				return profile, nil
+			}
+
+			loader := &contextLoader{}
+			ctx := context.WithValue(context.Background(), requestKey{}, "request")
+
+			_, err := deadenz.RunActionCommandCtx(
+				ctx,
+				search,
+				&components.Profile{Active: &components.Character{}},
+				loader,
+				[]deadenz.PreRunCtxFunc{preRun},
+				[]deadenz.PostRunCtxFunc{postRun},
+			)
+
+			require.NoError(t, err)
+
+			assert.Equal(t, "request", preRunValue)
+			assert.Equal(t, "request", postRunValue)
+			assert.Equal(t, "request", loader.ctx.Value(requestKey{}))
+		})
+	})
+}
+
+// contextLoader loads nothing and keeps the context of the last load.
+type contextLoader struct {
+	ctx context.Context
+}
+
+func (l *contextLoader) Load(value any) error {
+	return l.LoadCtx(context.Background(), value)
+}
+
+func (l *contextLoader) LoadCtx(ctx context.Context, _ any) error {
+	l.ctx = ctx
+
+	return nil
+}
@@ -1,7 +1,9 @@
 package deadenz
 
 import (
+	"context"
 	"errors"
+	"fmt"
 
 	"github.com/ciphermountain/deadenz/internal/util"
 	"github.com/ciphermountain/deadenz/pkg/components"
@@ -14,13 +16,18 @@ var (
 )
 
 func Walk(profile *components.Profile, loader Loader) (*components.Profile, []components.Event, error) {
+	return WalkCtx(context.Background(), profile, loader)
+}
+
+// WalkCtx is Walk with a context that is passed to the loader.
+func WalkCtx(ctx context.Context, profile *components.Profile, loader Loader) (*components.Profile, []components.Event, error) {
 	if profile.Active == nil {
 		return profile, nil, ErrNotSpawnedIn
 	}
 
 	which := util.Random(0, 100)
 
-	var nextFunc func(*components.Profile, Loader) (*components.Profile, []components.Event, error)
+	var nextFunc func(context.Context, *components.Profile, Loader) (*components.Profile, []components.Event, error)
 
 	// 35% of the time will result in a findable item
 	if which < 35 {
@@ -29,7 +36,7 @@ func Walk(profile *components.Profile, loader Loader) (*components.Profile, []co
 		nextFunc = encounter
 	}
 
-	p, evts, err := nextFunc(profile, loader)
+	p, evts, err := nextFunc(ctx, profile, loader)
 	if err != nil {
 		return profile, nil, err
 	}
@@ -49,14 +56,14 @@ func Walk(profile *components.Profile, loader Loader) (*components.Profile, []co
 	return profile, evts, nil
 }
 
-func findItem(profile *components.Profile, loader Loader) (*components.Profile, []components.Event, error) {
+func findItem(ctx context.Context, profile *components.Profile, loader Loader) (*components.Profile, []components.Event, error) {
 	var items []components.Item
-	if err := loader.Load(&items); err != nil {
+	if err := loader.LoadCtx(ctx, &items); err != nil {
 		return profile, nil, err
 	}
 
 	var decisions []events.ItemDecisionEvent
-	if err := loader.Load(&decisions); err != nil {
+	if err := loader.LoadCtx(ctx, &decisions); err != nil {
 		return profile, nil, err
 	}
 
@@ -84,9 +91,9 @@ func findItem(profile *components.Profile, loader Loader) (*components.Profile,
 	return profile, append(evts, dec), nil
 }
 
-func encounter(profile *components.Profile, loader Loader) (*components.Profile, []components.Event, error) {
+func encounter(ctx context.Context, profile *components.Profile, loader Loader) (*components.Profile, []components.Event, error) {
 	var encounters []events.EncounterEvent
-	if err := loader.Load(&encounters); err != nil {
+	if err := loader.LoadCtx(ctx, &encounters); err != nil {
 		return profile, nil, err
 	}
 
@@ -94,7 +101,7 @@ func encounter(profile *components.Profile, loader Loader) (*components.Profile,
 		encounters[util.Random(0, int64(len(encounters)-1))],
 	}
 
-	p, e, err := action(profile, loader)
+	p, e, err := action(ctx, profile, loader)
 	if err != nil {
 		return profile, nil, err
 	}
@@ -102,9 +109,9 @@ func encounter(profile *components.Profile, loader Loader) (*components.Profile,
 	return p, append(evts, e...), nil
 }
 
-func action(profile *components.Profile, loader Loader) (*components.Profile, []components.Event, error) {
+func action(ctx context.Context, profile *components.Profile, loader Loader) (*components.Profile, []components.Event, error) {
 	var actions []events.ActionEvent
-	if err := loader.Load(&actions); err != nil {
+	if err := loader.LoadCtx(ctx, &actions); err != nil {
 		return profile, nil, err
 	}
 
@@ -112,7 +119,7 @@ func action(profile *components.Profile, loader Loader) (*components.Profile, []
 		actions[util.Random(0, int64(len(actions)-1))],
 	}
 
-	p, e, err := mutation(profile, loader)
+	p, e, err := mutation(ctx, profile, loader)
 	if err != nil {
 		return profile, nil, err
 	}
@@ -120,14 +127,14 @@ func action(profile *components.Profile, loader Loader) (*components.Profile, []
 	return p, append(evts, e...), nil
 }
 
-func mutation(profile *components.Profile, loader Loader) (*components.Profile, []components.Event, error) {
+func mutation(ctx context.Context, profile *components.Profile, loader Loader) (*components.Profile, []components.Event, error) {
 	var live []events.LiveMutationEvent
-	if err := loader.Load(&live); err != nil {
+	if err := loader.LoadCtx(ctx, &live); err != nil {
 		return profile, nil, err
 	}
 
 	var die []events.DieMutationEvent
-	if err := loader.Load(&die); err != nil {
+	if err := loader.LoadCtx(ctx, &die); err != nil {
 		return profile, nil, err
 	}
 
@@ -139,11 +146,32 @@ func mutation(profile *components.Profile, loader Loader) (*components.Profile,
 }
 
 func addToBackpack(profile *components.Profile, item components.Item) (*components.Profile, error) {
-	if len(profile.Backpack) < int(profile.BackpackLimit) {
-		profile.Backpack = append([]components.ItemType{item.Type}, profile.Backpack...)
-	} else {
-		return profile, ErrBackpackTooSmall
+	backpack, err := profile.Backpack.Add(item, 1, profile.BackpackLimit, profile.CarryCapacity)
+	if err != nil {
+		return profile, fmt.Errorf("%w: %w", ErrBackpackTooSmall, err)
 	}
 
+	profile.Backpack = backpack
+
 	return profile, nil
 }
+
+// walkCommand reports a full backpack as an event instead of failing the walk. A repeated walk stops once an item
+// cannot be added to the backpack.
+func walkCommand(ctx context.Context, profile *components.Profile, loader Loader, _ CommandConfig) (*components.Profile, []components.Event, error) {
+	profile, evts, err := WalkCtx(ctx, profile, loader)
+	if err != nil {
+		if !errors.Is(err, ErrBackpackTooSmall) {
+			return profile, evts, err
+		}
+
+		message := "your backpack is too small"
+		if errors.Is(err, components.ErrTooHeavy) {
+			message = "your backpack is too heavy"
+		}
+
+		return profile, append(evts, events.NewItemDecisionEvent(message)), StopRepeat(err)
+	}
+
+	return profile, evts, nil
+}